@@ -2,15 +2,18 @@ import { useState } from 'react';
 import './App.css';
 import { useConfig, useLCU } from './contexts';
 import { Sidebar, Header } from './components';
-import { HomeTab, ProfileTab, ChampionsTab, MatchesTab, SettingsTab, DebugTab } from './pages';
+import { ApiError } from './utils/apiError';
+import { HomeTab, ProfileTab, ChampionsTab, MatchesTab, LiveGameTab, LadderTab, SettingsTab, DebugTab } from './pages';
 
-export type TabId = 'home' | 'profile' | 'champions' | 'matches' | 'settings' | 'debug';
+export type TabId = 'home' | 'profile' | 'champions' | 'matches' | 'live' | 'ladder' | 'settings' | 'debug';
 
 const TAB_TITLES: Record<TabId, string> = {
     home: 'Dashboard',
     profile: 'Profile',
     champions: 'Champions',
     matches: 'Match History',
+    live: 'Live Game',
+    ladder: 'Ranked Ladder',
     settings: 'Settings',
     debug: 'Debug',
 };
@@ -18,7 +21,7 @@ const TAB_TITLES: Record<TabId, string> = {
 function App() {
     const [activeTab, setActiveTab] = useState<TabId>('home');
     const { isConfigured, loading: configLoading } = useConfig();
-    const { status, summoner, loading: lcuLoading } = useLCU();
+    const { status, summoner, error: lcuError, loading: lcuLoading } = useLCU();
 
     const isLoading = configLoading || lcuLoading;
     const isConnected = status?.connected ?? false;
@@ -43,6 +46,7 @@ function App() {
                         summoner={summoner} 
                         isConnected={isConnected}
                         isConfigured={isConfigured}
+                        lcuError={lcuError}
                     />
                 </div>
             </main>
@@ -69,7 +73,18 @@ function getTabTitle(tab: TabId): string {
     return TAB_TITLES[tab] || 'Dashboard';
 }
 
-function NotConnectedMessage() {
+function NotConnectedMessage({ error }: { error: ApiError | null }) {
+    // not_connected means a client was found but the connection dropped
+    if (error?.code === 'not_connected') {
+        return (
+            <div className="message-card">
+                <div className="message-icon">🔌</div>
+                <h2>League Client Connection Lost</h2>
+                <p>Reconnecting to the League client...</p>
+            </div>
+        );
+    }
+
     return (
         <div className="message-card">
             <div className="message-icon">🔌</div>
@@ -85,7 +100,7 @@ function ApiKeyRequiredMessage() {
             <div className="message-icon">⚠️</div>
             <h2>API Key Required</h2>
             <p>Please add your Riot API key to use all features.</p>
-            <code className="code-block">internal/config/config.json</code>
+            <code className="code-block">Settings → Profiles</code>
             <a href="https://developer.riotgames.com/" target="_blank" className="btn-link">
                 Get API Key →
             </a>
@@ -98,18 +113,19 @@ interface TabContentProps {
     summoner: any;
     isConnected: boolean;
     isConfigured: boolean;
+    lcuError: ApiError | null;
 }
 
 // Tabs that don't require LCU connection
-const CONNECTION_FREE_TABS: TabId[] = ['settings', 'debug'];
+const CONNECTION_FREE_TABS: TabId[] = ['live', 'ladder', 'settings', 'debug'];
 
-function TabContent({ tab, summoner, isConnected, isConfigured }: TabContentProps) {
+function TabContent({ tab, summoner, isConnected, isConfigured, lcuError }: TabContentProps) {
     if (isConnectionFreeTab(tab)) {
         return renderConnectionFreeTab(tab, summoner);
     }
 
     if (!isConnected) {
-        return <NotConnectedMessage />;
+        return <NotConnectedMessage error={lcuError} />;
     }
 
     if (!isConfigured) {
@@ -125,6 +141,10 @@ function isConnectionFreeTab(tab: TabId): boolean {
 
 function renderConnectionFreeTab(tab: TabId, summoner: any) {
     switch (tab) {
+        case 'live':
+            return <LiveGameTab />;
+        case 'ladder':
+            return <LadderTab />;
         case 'settings':
             return <SettingsTab />;
         case 'debug':
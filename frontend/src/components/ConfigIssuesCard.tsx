@@ -0,0 +1,34 @@
+import { useState, useEffect } from 'react';
+import { EventsOn } from '../../wailsjs/runtime/runtime';
+import { GetConfigIssues } from '../../wailsjs/go/app/App';
+import { config } from '../../wailsjs/go/models';
+
+// Warns about config problems, e.g. an unknown region or a malformed API key.
+// Renders nothing while the config is fine.
+export function ConfigIssuesCard() {
+    const [issues, setIssues] = useState<config.Issue[]>([]);
+
+    useEffect(() => {
+        const load = () => GetConfigIssues().then((list) => setIssues(list || [])).catch(() => setIssues([]));
+        load();
+        return EventsOn('config-changed', load);
+    }, []);
+
+    if (issues.length === 0) {
+        return null;
+    }
+
+    return (
+        <div className="settings-card config-issues">
+            <h3>⚠️ Config Problems</h3>
+            {issues.map((issue, i) => (
+                <div key={i} className="setting-item">
+                    <div className="setting-info">
+                        <span className="setting-label">{issue.field || 'config file'}</span>
+                        <span className="setting-description">{issue.message} · from {issue.source}</span>
+                    </div>
+                </div>
+            ))}
+        </div>
+    );
+}
@@ -0,0 +1,127 @@
+import { useState, useEffect, useCallback } from 'react';
+import { EventsOn } from '../../wailsjs/runtime/runtime';
+import { GetProfiles, GetRegions, CreateProfile, SwitchProfile, DeleteProfile, SetAPIKey } from '../../wailsjs/go/app/App';
+import { config, lol } from '../../wailsjs/go/models';
+import { toApiError } from '../utils/apiError';
+
+// Lists the API key and region profiles and lets the user add, switch and delete them
+export function ProfilesCard() {
+    const [profiles, setProfiles] = useState<config.ProfileInfo[]>([]);
+    const [regions, setRegions] = useState<lol.Platform[]>([]);
+    const [name, setName] = useState('');
+    const [apiKey, setApiKey] = useState('');
+    const [region, setRegion] = useState('');
+    const [newKey, setNewKey] = useState('');
+    const [error, setError] = useState<string | null>(null);
+
+    const loadProfiles = useCallback(() => {
+        GetProfiles().then(setProfiles).catch(() => setProfiles([]));
+    }, []);
+
+    useEffect(() => {
+        loadProfiles();
+        GetRegions().then((list) => {
+            setRegions(list);
+            setRegion((current) => current || list[0]?.id || '');
+        });
+
+        return EventsOn('config-changed', loadProfiles);
+    }, [loadProfiles]);
+
+    const run = async (action: () => Promise<void>) => {
+        setError(null);
+        try {
+            await action();
+            loadProfiles();
+        } catch (err) {
+            setError(toApiError(err).message);
+        }
+    };
+
+    const handleCreate = () =>
+        run(async () => {
+            await CreateProfile(name, apiKey, region);
+            setName('');
+            setApiKey('');
+        });
+
+    const handleSetKey = () =>
+        run(async () => {
+            await SetAPIKey(newKey.trim());
+            setNewKey('');
+        });
+
+    return (
+        <div className="settings-card">
+            <h3>Profiles</h3>
+            {profiles.map((profile) => (
+                <div key={profile.name} className="setting-item">
+                    <div className="setting-info">
+                        <span className="setting-label">
+                            {profile.name}{profile.active ? ' (active)' : ''}
+                        </span>
+                        <span className="setting-description">
+                            {profile.region.toUpperCase()} · {profile.hasApiKey ? `API key ${profile.apiKeyHint}` : 'No API key'}
+                        </span>
+                    </div>
+                    <div className="profile-actions">
+                        {!profile.active && (
+                            <button className="btn-small" onClick={() => run(() => SwitchProfile(profile.name))}>
+                                Switch
+                            </button>
+                        )}
+                        <button
+                            className="btn-small btn-danger"
+                            disabled={profiles.length === 1}
+                            onClick={() => run(() => DeleteProfile(profile.name))}
+                        >
+                            Delete
+                        </button>
+                    </div>
+                </div>
+            ))}
+
+            <div className="setting-item">
+                <div className="profile-form">
+                    <input
+                        className="setting-input"
+                        type="password"
+                        placeholder="New API key for the active profile"
+                        value={newKey}
+                        onChange={(e) => setNewKey(e.target.value)}
+                    />
+                    <button className="btn-small" disabled={!newKey.trim()} onClick={handleSetKey}>
+                        Save key
+                    </button>
+                </div>
+            </div>
+
+            <div className="setting-item">
+                <div className="profile-form">
+                    <input
+                        className="setting-input"
+                        placeholder="Profile name"
+                        value={name}
+                        onChange={(e) => setName(e.target.value)}
+                    />
+                    <input
+                        className="setting-input"
+                        type="password"
+                        placeholder="Riot API key"
+                        value={apiKey}
+                        onChange={(e) => setApiKey(e.target.value)}
+                    />
+                    <select className="setting-select" value={region} onChange={(e) => setRegion(e.target.value)}>
+                        {regions.map((r) => (
+                            <option key={r.id} value={r.id}>{r.name}</option>
+                        ))}
+                    </select>
+                    <button className="btn-small" disabled={!name.trim()} onClick={handleCreate}>
+                        Add
+                    </button>
+                </div>
+            </div>
+            {error && <div className="setting-description">{error}</div>}
+        </div>
+    );
+}
@@ -0,0 +1,66 @@
+import { useState, useEffect } from 'react';
+import { EventsOn } from '../../wailsjs/runtime/runtime';
+import { GetScoutingReport } from '../../wailsjs/go/app/App';
+import { scouting, lol } from '../../wailsjs/go/models';
+
+const SOLO_QUEUE = 'RANKED_SOLO_5x5';
+
+// Shows the champ select scouting report while one is available
+export function ScoutingCard() {
+    const [players, setPlayers] = useState<scouting.Report[] | null>(null);
+
+    useEffect(() => {
+        GetScoutingReport().then(setPlayers).catch(() => setPlayers(null));
+
+        const off = EventsOn('scouting-report', (data: { players: scouting.Report[] }) => setPlayers(data.players));
+        return () => off();
+    }, []);
+
+    if (!players || players.length === 0) {
+        return null;
+    }
+
+    return (
+        <div className="debug-card">
+            <h3>🔍 Lobby Scouting</h3>
+            <div className="debug-grid">
+                {players.map((player) => (
+                    <div key={player.cellId} className="debug-row">
+                        <span className="debug-key">
+                            {player.position ? `${player.position} · ` : ''}
+                            {player.hidden ? 'Hidden player' : `${player.gameName}#${player.tagLine}`}
+                            {player.isLocal ? ' (you)' : ''}
+                        </span>
+                        <span className="debug-value">{describePlayer(player)}</span>
+                    </div>
+                ))}
+            </div>
+        </div>
+    );
+}
+
+function describePlayer(player: scouting.Report): string {
+    if (player.hidden) return '—';
+    if (player.error) return player.error;
+
+    const parts = [formatRank((player.ranked ?? []).find((r) => r.queueType === SOLO_QUEUE))];
+    if (player.recentGames > 0) {
+        parts.push(`${Math.round(player.recentWinRate * 100)}% in last ${player.recentGames}`);
+    }
+    if (player.mainRoles?.length) {
+        parts.push(player.mainRoles.slice(0, 2).map((r) => r.position).join('/'));
+    }
+    if (player.mastery) {
+        parts.push(`M${player.mastery.championLevel} ${player.mastery.championPoints.toLocaleString()} pts`);
+    } else if (player.championId) {
+        parts.push('no mastery');
+    }
+    return parts.join(' · ');
+}
+
+function formatRank(entry?: lol.RankedInfo): string {
+    if (!entry) return 'Unranked';
+    const games = entry.wins + entry.losses;
+    const winRate = games > 0 ? Math.round((entry.wins / games) * 100) : 0;
+    return `${entry.tier} ${entry.rank} ${entry.leaguePoints} LP (${winRate}% of ${games})`;
+}
@@ -21,6 +21,8 @@ export function Sidebar({ activeTab, onTabChange }: SidebarProps) {
         { id: 'profile', icon: '👤', label: 'Profile' },
         { id: 'champions', icon: '⚔️', label: 'Champions' },
         { id: 'matches', icon: '📊', label: 'Matches' },
+        { id: 'live', icon: '🔴', label: 'Live Game' },
+        { id: 'ladder', icon: '🏆', label: 'Ladder' },
         { id: 'settings', icon: '⚙️', label: 'Settings' },
         { id: 'debug', icon: '🐛', label: 'Debug', hidden: !settings.showDebug },
     ];
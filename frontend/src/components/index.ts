@@ -1,4 +1,7 @@
 export { StatusBar } from './StatusBar';
 export { UserCard } from './UserCard';
+export { ScoutingCard } from './ScoutingCard';
+export { ProfilesCard } from './ProfilesCard';
+export { ConfigIssuesCard } from './ConfigIssuesCard';
 export { Sidebar } from './Sidebar';
 export { Header } from './Header';
@@ -12,6 +12,8 @@ export interface ApiLogEntry {
     headers?: Record<string, string>;
     response?: any;
     error?: string;
+    attempt?: number;
+    cached?: boolean;
 }
 
 interface ApiLogContextType {
@@ -50,6 +52,8 @@ export function ApiLogProvider({ children }: { children: ReactNode }) {
                 headers: data.headers || {},
                 response: data.response,
                 error: data.error,
+                attempt: typeof data.attempt === 'number' ? data.attempt : undefined,
+                cached: data.cached === true,
             };
 
             setLogs(prev => [newEntry, ...prev].slice(0, MAX_LOGS));
@@ -1,4 +1,5 @@
 import { createContext, useContext, useState, useEffect, ReactNode } from 'react';
+import { EventsOn } from '../../wailsjs/runtime/runtime';
 import { GetConfig, IsConfigured } from "../../wailsjs/go/app/App";
 import { config } from "../../wailsjs/go/models";
 
@@ -32,6 +33,9 @@ export function ConfigProvider({ children }: { children: ReactNode }) {
         }
 
         loadConfig();
+
+        // Sent for changes made in the app, e.g. switching profiles, and for edits to config.json
+        return EventsOn('config-changed', () => loadConfig());
     }, []);
 
     const value: ConfigContextType = {
@@ -3,6 +3,7 @@ import { EventsOn } from '../../wailsjs/runtime/runtime';
 import { GetLCUStatus, GetCurrentSummoner } from "../../wailsjs/go/app/App";
 import { lcu, app } from "../../wailsjs/go/models";
 import { usePolling } from '../hooks';
+import { ApiError, toApiError } from '../utils/apiError';
 
 // Polling intervals (ms)
 const INTERVAL = {
@@ -16,7 +17,7 @@ interface LCUContextType {
     status: app.LCUStatus | null;
     summoner: lcu.CurrentSummoner | null;
     loading: boolean;
-    error: string | null;
+    error: ApiError | null;
     isPolling: boolean;
     refresh: () => Promise<void>;
 }
@@ -27,7 +28,7 @@ export function LCUProvider({ children }: { children: ReactNode }) {
     const [status, setStatus] = useState<app.LCUStatus | null>(null);
     const [summoner, setSummoner] = useState<lcu.CurrentSummoner | null>(null);
     const [loading, setLoading] = useState(true);
-    const [error, setError] = useState<string | null>(null);
+    const [error, setError] = useState<ApiError | null>(null);
     
     const statusRef = useRef(status);
     const prevConnectedRef = useRef<boolean | null>(null);
@@ -89,8 +90,12 @@ export function LCUProvider({ children }: { children: ReactNode }) {
 
         try {
             return await GetCurrentSummoner();
-        } catch {
-            // Backend handles errors and emits events
+        } catch (err) {
+            // The client went away between status polls; other errors are retried on the next poll
+            const apiError = toApiError(err);
+            if (apiError.code === 'not_connected' || apiError.code === 'client_not_running') {
+                handleStatusResultRef.current?.({ connected: false, error: apiError.message });
+            }
             return null;
         }
     }, []);
@@ -101,8 +106,8 @@ export function LCUProvider({ children }: { children: ReactNode }) {
         }
     }, []);
 
-    const handleStatusError = useCallback((err: Error) => {
-        setError(err.message);
+    const handleStatusError = useCallback((err: ApiError) => {
+        setError(err);
         const errorStatus: app.LCUStatus = { connected: false, error: err.message };
         setStatus(errorStatus);
         prevConnectedRef.current = false;
@@ -1,4 +1,5 @@
 import { useEffect, useRef, useCallback, useState } from 'react';
+import { ApiError, toApiError } from '../utils/apiError';
 
 export interface PollingTask<T> {
     /** Unique task identifier */
@@ -6,11 +7,11 @@ export interface PollingTask<T> {
     /** Function that performs the polling */
     execute: () => Promise<T>;
     /** Get interval based on current result (dynamic intervals) */
-    getInterval: (result: T | null, error: Error | null) => number;
+    getInterval: (result: T | null, error: ApiError | null) => number;
     /** Called when polling completes */
     onResult?: (result: T) => void;
-    /** Called on error */
-    onError?: (error: Error) => void;
+    /** Called on error, with the backend error code */
+    onError?: (error: ApiError) => void;
     /** Whether this task is enabled (default: true) */
     enabled?: boolean;
 }
@@ -48,13 +49,13 @@ export function usePolling<T>(task: PollingTask<T>): {
         setIsPolling(true);
 
         let result: T | null = null;
-        let error: Error | null = null;
+        let error: ApiError | null = null;
 
         try {
             result = await currentTask.execute();
             currentTask.onResult?.(result);
         } catch (err) {
-            error = err instanceof Error ? err : new Error(String(err));
+            error = toApiError(err);
             currentTask.onError?.(error);
         }
 
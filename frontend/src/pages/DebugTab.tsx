@@ -1,7 +1,10 @@
-import { useState } from 'react';
+import { useState, useEffect } from 'react';
 import { useConfig, useLCU, useTheme, useApiLog } from '../contexts';
 import { useWindowSize } from '../hooks';
-import { lcu } from '../../wailsjs/go/models';
+import { config as configModels, lcu, lol } from '../../wailsjs/go/models';
+import { ClearResponseCache, GetConfigDoctor, GetProfiles, GetRateLimitState, GetSecretBackend } from '../../wailsjs/go/app/App';
+
+const RATE_LIMIT_REFRESH_MS = 1000;
 
 interface DebugTabProps {
     summoner: lcu.CurrentSummoner | null;
@@ -14,8 +17,35 @@ export function DebugTab({ summoner }: DebugTabProps) {
     const { logs } = useApiLog();
     const [expandedLog, setExpandedLog] = useState<string | null>(null);
     const [copiedId, setCopiedId] = useState<string | null>(null);
+    const [rateLimits, setRateLimits] = useState<lol.RateLimitState[]>([]);
+    const [profile, setProfile] = useState<configModels.ProfileInfo | null>(null);
+    const [secretBackend, setSecretBackend] = useState('');
+    const [doctor, setDoctor] = useState<configModels.DoctorReport | null>(null);
     const windowSize = useWindowSize();
 
+    useEffect(() => {
+        GetProfiles()
+            .then((profiles) => setProfile(profiles.find((p) => p.active) || null))
+            .catch(() => setProfile(null));
+        GetSecretBackend().then(setSecretBackend).catch(() => setSecretBackend(''));
+        GetConfigDoctor().then(setDoctor).catch(() => setDoctor(null));
+    }, [config]);
+
+    useEffect(() => {
+        const refresh = () => GetRateLimitState().then(setRateLimits).catch(() => setRateLimits([]));
+        refresh();
+        const timer = setInterval(refresh, RATE_LIMIT_REFRESH_MS);
+        return () => clearInterval(timer);
+    }, []);
+
+    const formatRateLimit = (state: lol.RateLimitState): string => {
+        const windows = state.windows
+            .map((w) => `${w.used}/${w.limit} per ${w.windowSeconds}s`)
+            .join(', ');
+        const blocked = state.blockedForMs > 0 ? ` (blocked ${Math.ceil(state.blockedForMs / 1000)}s)` : '';
+        return (windows || 'no limits reported') + blocked;
+    };
+
     const debugInfo = {
         'App Version': '1.0.0',
         'Window Size': `${windowSize.width} × ${windowSize.height}`,
@@ -24,7 +54,9 @@ export function DebugTab({ summoner }: DebugTabProps) {
         'LCU Port': status?.port || 'N/A',
         'LCU Polling': isPolling ? 'Yes' : 'No',
         'Region': config?.region || 'N/A',
-        'API Key': config?.riot_api_key ? `${config.riot_api_key.slice(0, 12)}...` : 'Not set',
+        'Profile': profile?.name || 'N/A',
+        'API Key': profile?.apiKeyHint || 'Not set',
+        'Key Storage': secretBackend || 'N/A',
         'Summoner PUUID': summoner?.puuid ? `${summoner.puuid.slice(0, 20)}...` : 'N/A',
         'Summoner ID': summoner?.summonerId || 'N/A',
         'Account ID': summoner?.accountId || 'N/A',
@@ -109,6 +141,13 @@ export function DebugTab({ summoner }: DebugTabProps) {
                     <h3>🛰 API Calls</h3>
                     <div className="debug-actions">
                         <span className="log-count">{logs.length} calls</span>
+                        <button
+                            className="api-copy-btn"
+                            onClick={() => ClearResponseCache().catch((err) => console.error('Failed to clear cache:', err))}
+                            title="Drop cached Riot API responses"
+                        >
+                            🗑 Clear cache
+                        </button>
                     </div>
                 </div>
 
@@ -132,7 +171,12 @@ export function DebugTab({ summoner }: DebugTabProps) {
                                     <span className="api-log-method">{log.method}</span>
                                     <span className="api-log-endpoint">{log.endpoint}</span>
                                     <span className="api-log-time">{formatTime(log.timestamp)}</span>
-                                    {log.duration !== undefined && (
+                                    {log.attempt !== undefined && log.attempt > 1 && (
+                                        <span className="api-log-attempt">retry #{log.attempt - 1}</span>
+                                    )}
+                                    {log.cached ? (
+                                        <span className="api-log-cached">cached</span>
+                                    ) : log.duration !== undefined && (
                                         <span className="api-log-duration">{log.duration}ms</span>
                                     )}
                                     <span className="api-log-expand">{expandedLog === log.id ? '▼' : '▶'}</span>
@@ -212,6 +256,46 @@ export function DebugTab({ summoner }: DebugTabProps) {
                 </div>
             </div>
 
+            <div className="debug-card">
+                <h3>⏱ Riot API Rate Limits</h3>
+                <div className="debug-grid">
+                    {rateLimits.length === 0 ? (
+                        <div className="api-log-empty">No Riot API calls made yet.</div>
+                    ) : (
+                        rateLimits.map((state) => (
+                            <div key={`${state.host}${state.method ?? ''}`} className="debug-row">
+                                <span className="debug-key">{state.host}{state.method ?? ' (app)'}</span>
+                                <span className="debug-value">{formatRateLimit(state)}</span>
+                            </div>
+                        ))
+                    )}
+                </div>
+            </div>
+
+            {doctor && (
+                <div className="debug-card">
+                    <h3>🩺 Config Doctor</h3>
+                    <div className="debug-grid">
+                        <div className="debug-row">
+                            <span className="debug-key">Config File</span>
+                            <span className="debug-value">{doctor.path} (schema {doctor.version})</span>
+                        </div>
+                        {doctor.values.map((v) => (
+                            <div key={v.key} className="debug-row" title={`Override with ${v.env} or ${v.flag}`}>
+                                <span className="debug-key">{v.key}</span>
+                                <span className="debug-value">{v.value || '(empty)'} · {v.source}</span>
+                            </div>
+                        ))}
+                        {doctor.issues.map((issue, i) => (
+                            <div key={i} className="debug-row config-doctor-issue">
+                                <span className="debug-key">⚠️ {issue.field || 'config file'}</span>
+                                <span className="debug-value">{issue.message} ({issue.source})</span>
+                            </div>
+                        ))}
+                    </div>
+                </div>
+            )}
+
             <div className="debug-card">
                 <h3>🐛 Debug Information</h3>
                 <div className="debug-grid">
@@ -1,4 +1,4 @@
-import { UserCard } from '../components';
+import { UserCard, ScoutingCard } from '../components';
 import { lcu } from '../../wailsjs/go/models';
 
 interface HomeTabProps {
@@ -9,6 +9,7 @@ export function HomeTab({ summoner }: HomeTabProps) {
     return (
         <div className="tab-content">
             <UserCard summoner={summoner} />
+            <ScoutingCard />
             <QuickStats />
         </div>
     );
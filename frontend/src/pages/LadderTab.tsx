@@ -0,0 +1,105 @@
+import { useState, useEffect } from 'react';
+import { GetLadder } from '../../wailsjs/go/app/App';
+import { lol } from '../../wailsjs/go/models';
+import { ApiError, toApiError } from '../utils/apiError';
+
+const QUEUES = [
+    { id: 'RANKED_SOLO_5x5', label: 'Solo/Duo' },
+    { id: 'RANKED_FLEX_SR', label: 'Flex' },
+];
+
+const TIERS = ['CHALLENGER', 'GRANDMASTER', 'MASTER', 'DIAMOND', 'EMERALD', 'PLATINUM', 'GOLD', 'SILVER', 'BRONZE', 'IRON'];
+
+export function LadderTab() {
+    const [queue, setQueue] = useState(QUEUES[0].id);
+    const [tier, setTier] = useState(TIERS[0]);
+    const [ladder, setLadder] = useState<lol.Ladder | null>(null);
+    const [loading, setLoading] = useState(false);
+    const [error, setError] = useState<ApiError | null>(null);
+
+    useEffect(() => {
+        let cancelled = false;
+        setLoading(true);
+        setError(null);
+
+        GetLadder(queue, tier)
+            .then((result) => !cancelled && setLadder(result))
+            .catch((err) => !cancelled && setError(toApiError(err)))
+            .finally(() => !cancelled && setLoading(false));
+
+        return () => {
+            cancelled = true;
+        };
+    }, [queue, tier]);
+
+    return (
+        <div className="tab-content">
+            <div className="debug-card">
+                <div className="debug-card-header">
+                    <h3>🏆 Ladder</h3>
+                    <div className="ladder-filters">
+                        <select className="setting-select" value={queue} onChange={(e) => setQueue(e.target.value)}>
+                            {QUEUES.map((q) => (
+                                <option key={q.id} value={q.id}>{q.label}</option>
+                            ))}
+                        </select>
+                        <select className="setting-select" value={tier} onChange={(e) => setTier(e.target.value)}>
+                            {TIERS.map((t) => (
+                                <option key={t} value={t}>{formatTier(t)}</option>
+                            ))}
+                        </select>
+                    </div>
+                </div>
+
+                {loading && (
+                    <div className="api-log-empty">
+                        Loading ladder... Below Master the whole top division is read, which can take a while.
+                    </div>
+                )}
+                {!loading && error && (
+                    <div className="api-log-empty">
+                        {error.code === 'not_configured'
+                            ? 'Add a Riot API key under Settings → Profiles to see the ladder.'
+                            : error.message}
+                    </div>
+                )}
+                {!loading && !error && ladder && (
+                    <>
+                        {ladder.entries.length === 0 ? (
+                            <div className="api-log-empty">No players in this tier.</div>
+                        ) : (
+                            <table className="live-table">
+                                <tbody>
+                                    {ladder.entries.map((entry, i) => (
+                                        <tr key={entry.puuid || i}>
+                                            <td>{entry.position > 0 ? `#${entry.position}` : '–'}</td>
+                                            <td>{entry.gameName ? `${entry.gameName}#${entry.tagLine}` : 'Unknown player'}</td>
+                                            <td>{formatTier(entry.tier)} {entry.rank}</td>
+                                            <td>{entry.leaguePoints} LP</td>
+                                            <td>{entry.wins}W {entry.losses}L</td>
+                                            <td>{winRate(entry)}%</td>
+                                        </tr>
+                                    ))}
+                                </tbody>
+                            </table>
+                        )}
+                        {ladder.partial && (
+                            <div className="api-log-empty">
+                                This is a sample: a division had more pages than were read, so positions are not shown.
+                            </div>
+                        )}
+                    </>
+                )}
+            </div>
+        </div>
+    );
+}
+
+function formatTier(tier: string): string {
+    return tier.charAt(0) + tier.slice(1).toLowerCase();
+}
+
+function winRate(entry: lol.LadderEntry): number {
+    const games = entry.wins + entry.losses;
+    return games > 0 ? Math.round((entry.wins / games) * 100) : 0;
+}
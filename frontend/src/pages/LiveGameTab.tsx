@@ -0,0 +1,161 @@
+import { useState, useEffect } from 'react';
+import { EventsOn } from '../../wailsjs/runtime/runtime';
+import { GetLiveGame } from '../../wailsjs/go/app/App';
+import { liveclient } from '../../wailsjs/go/models';
+
+const MAX_EVENTS = 30;
+
+const TEAM_LABELS: Record<string, string> = {
+    ORDER: 'Blue',
+    CHAOS: 'Red',
+};
+
+export function LiveGameTab() {
+    const [game, setGame] = useState<liveclient.Snapshot | null>(null);
+
+    useEffect(() => {
+        GetLiveGame().then(setGame).catch(() => setGame(null));
+
+        const offUpdate = EventsOn('live-game-update', (snapshot: liveclient.Snapshot) => setGame(snapshot));
+        const offEnded = EventsOn('live-game-ended', () => setGame(null));
+        return () => {
+            offUpdate();
+            offEnded();
+        };
+    }, []);
+
+    if (!game) {
+        return (
+            <div className="tab-content">
+                <div className="placeholder-card">
+                    <span className="placeholder-icon">🎮</span>
+                    <p>No game in progress</p>
+                </div>
+            </div>
+        );
+    }
+
+    const events = [...(game.events || [])].reverse().slice(0, MAX_EVENTS);
+
+    return (
+        <div className="tab-content">
+            <div className="debug-card">
+                <div className="debug-card-header">
+                    <h3>🔴 {game.gameMode} · {formatGameTime(game.gameTime)}</h3>
+                    <span className={`live-gold-diff ${goldDiffClass(game)}`}>
+                        {formatGoldDiff(game.goldDiff)}
+                    </span>
+                </div>
+                <div className="live-teams">
+                    {game.teams.map((team) => (
+                        <TeamTable
+                            key={team.team}
+                            team={team}
+                            players={game.players.filter((p) => p.team === team.team)}
+                            isActiveTeam={team.team === game.activeTeam}
+                        />
+                    ))}
+                </div>
+            </div>
+
+            <div className="debug-card">
+                <h3>📜 Events</h3>
+                <div className="debug-grid">
+                    {events.length === 0 ? (
+                        <div className="api-log-empty">No events yet.</div>
+                    ) : (
+                        events.map((event) => (
+                            <div key={event.EventID} className="debug-row">
+                                <span className="debug-key">{formatGameTime(event.EventTime)}</span>
+                                <span className="debug-value">{describeEvent(event)}</span>
+                            </div>
+                        ))
+                    )}
+                </div>
+            </div>
+        </div>
+    );
+}
+
+interface TeamTableProps {
+    team: liveclient.TeamSummary;
+    players: liveclient.Player[];
+    isActiveTeam: boolean;
+}
+
+function TeamTable({ team, players, isActiveTeam }: TeamTableProps) {
+    return (
+        <div className={`live-team ${team.team.toLowerCase()}`}>
+            <div className="live-team-header">
+                <strong>{TEAM_LABELS[team.team] || team.team}{isActiveTeam ? ' (you)' : ''}</strong>
+                <span>{team.kills} kills · {team.itemGold.toLocaleString()}g items</span>
+                <span>🗼 {team.turrets} · 🐉 {team.dragons} · 🪲 {team.heralds} · 👾 {team.barons}</span>
+            </div>
+            <table className="live-table">
+                <tbody>
+                    {players.map((player) => (
+                        <tr key={player.riotId || player.summonerName} className={player.isDead ? 'dead' : ''}>
+                            <td>{player.championName}</td>
+                            <td>{player.riotIdGameName || player.summonerName}</td>
+                            <td>Lv {player.level}</td>
+                            <td>{player.scores.kills}/{player.scores.deaths}/{player.scores.assists}</td>
+                            <td>{player.scores.creepScore} CS</td>
+                            <td>{player.isDead ? `💀 ${Math.ceil(player.respawnTimer)}s` : ''}</td>
+                        </tr>
+                    ))}
+                </tbody>
+            </table>
+        </div>
+    );
+}
+
+function formatGameTime(seconds: number): string {
+    const total = Math.floor(seconds);
+    const minutes = Math.floor(total / 60);
+    return `${minutes}:${String(total % 60).padStart(2, '0')}`;
+}
+
+function formatGoldDiff(diff: number): string {
+    if (diff === 0) return 'Even gold';
+    const leader = diff > 0 ? TEAM_LABELS.ORDER : TEAM_LABELS.CHAOS;
+    return `${leader} +${Math.abs(diff).toLocaleString()}g (items)`;
+}
+
+function goldDiffClass(game: liveclient.Snapshot): string {
+    if (game.goldDiff === 0 || !game.activeTeam) return '';
+    const ahead = game.goldDiff > 0 ? 'ORDER' : 'CHAOS';
+    return ahead === game.activeTeam ? 'ahead' : 'behind';
+}
+
+function describeEvent(event: liveclient.Event): string {
+    switch (event.EventName) {
+        case 'GameStart':
+            return 'Game started';
+        case 'MinionsSpawning':
+            return 'Minions spawning';
+        case 'FirstBlood':
+            return `First blood: ${event.Recipient}`;
+        case 'ChampionKill':
+            return `${event.KillerName} killed ${event.VictimName}`;
+        case 'Multikill':
+            return `${event.KillerName} multikill (${event.KillStreak})`;
+        case 'Ace':
+            return `Ace by ${event.Acer}`;
+        case 'FirstBrick':
+            return `First tower: ${event.KillerName}`;
+        case 'TurretKilled':
+            return `Turret destroyed by ${event.KillerName}`;
+        case 'InhibKilled':
+            return `Inhibitor destroyed by ${event.KillerName}`;
+        case 'DragonKill':
+            return `${event.DragonType} dragon taken by ${event.KillerName}${event.Stolen === 'True' ? ' (stolen)' : ''}`;
+        case 'HeraldKill':
+            return `Rift Herald taken by ${event.KillerName}${event.Stolen === 'True' ? ' (stolen)' : ''}`;
+        case 'BaronKill':
+            return `Baron taken by ${event.KillerName}${event.Stolen === 'True' ? ' (stolen)' : ''}`;
+        case 'GameEnd':
+            return `Game over: ${event.Result}`;
+        default:
+            return event.EventName;
+    }
+}
@@ -1,6 +1,7 @@
 import { useEffect, useRef } from 'react';
 import { EventsOn } from '../../wailsjs/runtime/runtime';
 import { useSettings, useTheme, useLCU } from '../contexts';
+import { ConfigIssuesCard, ProfilesCard } from '../components';
 import { StartAutoAccept, StopAutoAccept, IsAutoAcceptRunning } from '../../wailsjs/go/app/App';
 import { app } from '../../wailsjs/go/models';
 
@@ -114,6 +115,9 @@ export function SettingsTab() {
 
     return (
         <div className="tab-content">
+            <ConfigIssuesCard />
+            <ProfilesCard />
+
             <div className="settings-card">
                 <h3>Appearance</h3>
                 <div className="setting-item">
@@ -2,6 +2,8 @@ export { HomeTab } from './HomeTab';
 export { ProfileTab } from './ProfileTab';
 export { ChampionsTab } from './ChampionsTab';
 export { MatchesTab } from './MatchesTab';
+export { LiveGameTab } from './LiveGameTab';
+export { LadderTab } from './LadderTab';
 export { SettingsTab } from './SettingsTab';
 export { DebugTab } from './DebugTab';
 
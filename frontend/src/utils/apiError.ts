@@ -0,0 +1,30 @@
+// Error codes sent by backend methods (internal/apierr)
+export type ApiErrorCode =
+    | 'unknown'
+    | 'not_configured'
+    | 'not_connected'
+    | 'client_not_running'
+    | 'network'
+    | 'bad_request'
+    | 'unauthorized'
+    | 'forbidden'
+    | 'not_found'
+    | 'rate_limited'
+    | 'unavailable';
+
+export interface ApiError {
+    code: ApiErrorCode;
+    message: string;
+    status?: number;
+}
+
+// Normalizes the rejection of a backend call into an ApiError
+export function toApiError(err: unknown): ApiError {
+    if (err && typeof err === 'object' && 'code' in err && 'message' in err) {
+        return err as ApiError;
+    }
+    return {
+        code: 'unknown',
+        message: err instanceof Error ? err.message : String(err),
+    };
+}
@@ -1,9 +1,29 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
+import {history} from '../models';
 import {lol} from '../models';
 import {config} from '../models';
 import {lcu} from '../models';
 import {app} from '../models';
+import {liveclient} from '../models';
+import {scouting} from '../models';
+import {presets} from '../models';
+
+export function ClearResponseCache():Promise<void>;
+
+export function CreateProfile(arg1:string,arg2:string,arg3:string):Promise<void>;
+
+export function DeleteItemSetPreset(arg1:number,arg2:string,arg3:number):Promise<void>;
+
+export function DeleteProfile(arg1:string):Promise<void>;
+
+export function DeleteRunePreset(arg1:number,arg2:string):Promise<void>;
+
+export function DeleteSpellPreset(arg1:number,arg2:string,arg3:number):Promise<void>;
+
+export function GetAccountStats(arg1:string,arg2:number):Promise<history.AccountStats>;
+
+export function GetActiveGame(arg1:string):Promise<lol.ActiveGame>;
 
 export function GetAllChampionMasteries(arg1:string):Promise<Array<lol.ChampionMasteryInfo>>;
 
@@ -13,16 +33,48 @@ export function GetChampionMastery(arg1:string,arg2:string):Promise<lol.Champion
 
 export function GetConfig():Promise<config.Config>;
 
+export function GetConfigDoctor():Promise<config.DoctorReport>;
+
+export function GetConfigIssues():Promise<Array<config.Issue>>;
+
 export function GetCurrentSummoner():Promise<lcu.CurrentSummoner>;
 
 export function GetGrandmasters(arg1:string):Promise<lol.LeagueListInfo>;
 
 export function GetLCUStatus():Promise<app.LCUStatus>;
 
+export function GetLadder(arg1:string,arg2:string):Promise<lol.Ladder>;
+
+export function GetLeagueEntries(arg1:string,arg2:string,arg3:string,arg4:number):Promise<Array<lol.RankedInfo>>;
+
+export function GetLiveGame():Promise<liveclient.Snapshot>;
+
 export function GetMasters(arg1:string):Promise<lol.LeagueListInfo>;
 
+export function GetMatch(arg1:string):Promise<lol.MatchInfo>;
+
+export function GetMatchAnalysis(arg1:string):Promise<lol.MatchAnalysis>;
+
+export function GetMatchIDs(arg1:string,arg2:lol.MatchFilters):Promise<Array<string>>;
+
+export function GetMatchTimeline(arg1:string):Promise<lol.MatchTimeline>;
+
+export function GetProfiles():Promise<Array<config.ProfileInfo>>;
+
+export function GetRankProgression(arg1:string,arg2:string,arg3:number):Promise<history.RankProgression>;
+
 export function GetRankedStats(arg1:string):Promise<Array<lol.RankedInfo>>;
 
+export function GetRateLimitState():Promise<Array<lol.RateLimitState>>;
+
+export function GetRegions():Promise<Array<lol.Platform>>;
+
+export function GetScoutingReport():Promise<Array<scouting.Report>>;
+
+export function GetSecretBackend():Promise<string>;
+
+export function GetStoredMatches(arg1:string,arg2:number,arg3:number):Promise<Array<lol.MatchInfo>>;
+
 export function GetSummonerByID(arg1:string):Promise<lol.SummonerInfo>;
 
 export function GetSummonerByPUUID(arg1:string):Promise<lol.SummonerInfo>;
@@ -31,16 +83,50 @@ export function GetTotalMasteryScore(arg1:string):Promise<number>;
 
 export function IsAutoAcceptRunning():Promise<boolean>;
 
+export function IsChampSelectRunning():Promise<boolean>;
+
 export function IsConfigured():Promise<boolean>;
 
+export function ListItemSetPresets():Promise<Array<presets.ItemSetPreset>>;
+
+export function ListRunePresets():Promise<Array<presets.RunePreset>>;
+
+export function ListSpellPresets():Promise<Array<presets.SpellPreset>>;
+
+export function ListTrackedAccounts():Promise<Array<history.TrackedAccount>>;
+
+export function SaveCurrentRunePage(arg1:number,arg2:string):Promise<presets.RunePreset>;
+
+export function SaveItemSetPreset(arg1:presets.ItemSetPreset):Promise<void>;
+
+export function SaveRunePreset(arg1:presets.RunePreset):Promise<void>;
+
+export function SaveSpellPreset(arg1:presets.SpellPreset):Promise<void>;
+
 export function SearchSummoner(arg1:string):Promise<lol.SummonerInfo>;
 
 export function SetAPIKey(arg1:string):Promise<void>;
 
 export function SetRegion(arg1:string):Promise<void>;
 
+export function SetSpellSlotOrder(arg1:string):Promise<void>;
+
 export function StartAutoAccept(arg1:app.AutoAcceptConfig):Promise<void>;
 
+export function StartChampSelect(arg1:app.ChampSelectConfig):Promise<void>;
+
 export function StopAutoAccept():Promise<void>;
 
+export function StopChampSelect():Promise<void>;
+
+export function SwitchProfile(arg1:string):Promise<void>;
+
+export function SyncMatchHistory():Promise<Array<history.SyncResult>>;
+
+export function TrackAccount(arg1:string):Promise<history.TrackedAccount>;
+
+export function UntrackAccount(arg1:string):Promise<void>;
+
 export function UpdateAutoAcceptConfig(arg1:app.AutoAcceptConfig):Promise<void>;
+
+export function UpdateChampSelectConfig(arg1:app.ChampSelectConfig):Promise<void>;
@@ -2,6 +2,38 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function ClearResponseCache() {
+  return window['go']['app']['App']['ClearResponseCache']();
+}
+
+export function CreateProfile(arg1, arg2, arg3) {
+  return window['go']['app']['App']['CreateProfile'](arg1, arg2, arg3);
+}
+
+export function DeleteItemSetPreset(arg1, arg2, arg3) {
+  return window['go']['app']['App']['DeleteItemSetPreset'](arg1, arg2, arg3);
+}
+
+export function DeleteProfile(arg1) {
+  return window['go']['app']['App']['DeleteProfile'](arg1);
+}
+
+export function DeleteRunePreset(arg1, arg2) {
+  return window['go']['app']['App']['DeleteRunePreset'](arg1, arg2);
+}
+
+export function DeleteSpellPreset(arg1, arg2, arg3) {
+  return window['go']['app']['App']['DeleteSpellPreset'](arg1, arg2, arg3);
+}
+
+export function GetAccountStats(arg1, arg2) {
+  return window['go']['app']['App']['GetAccountStats'](arg1, arg2);
+}
+
+export function GetActiveGame(arg1) {
+  return window['go']['app']['App']['GetActiveGame'](arg1);
+}
+
 export function GetAllChampionMasteries(arg1) {
   return window['go']['app']['App']['GetAllChampionMasteries'](arg1);
 }
@@ -18,6 +50,14 @@ export function GetConfig() {
   return window['go']['app']['App']['GetConfig']();
 }
 
+export function GetConfigDoctor() {
+  return window['go']['app']['App']['GetConfigDoctor']();
+}
+
+export function GetConfigIssues() {
+  return window['go']['app']['App']['GetConfigIssues']();
+}
+
 export function GetCurrentSummoner() {
   return window['go']['app']['App']['GetCurrentSummoner']();
 }
@@ -30,14 +70,70 @@ export function GetLCUStatus() {
   return window['go']['app']['App']['GetLCUStatus']();
 }
 
+export function GetLadder(arg1, arg2) {
+  return window['go']['app']['App']['GetLadder'](arg1, arg2);
+}
+
+export function GetLeagueEntries(arg1, arg2, arg3, arg4) {
+  return window['go']['app']['App']['GetLeagueEntries'](arg1, arg2, arg3, arg4);
+}
+
+export function GetLiveGame() {
+  return window['go']['app']['App']['GetLiveGame']();
+}
+
 export function GetMasters(arg1) {
   return window['go']['app']['App']['GetMasters'](arg1);
 }
 
+export function GetMatch(arg1) {
+  return window['go']['app']['App']['GetMatch'](arg1);
+}
+
+export function GetMatchAnalysis(arg1) {
+  return window['go']['app']['App']['GetMatchAnalysis'](arg1);
+}
+
+export function GetMatchIDs(arg1, arg2) {
+  return window['go']['app']['App']['GetMatchIDs'](arg1, arg2);
+}
+
+export function GetMatchTimeline(arg1) {
+  return window['go']['app']['App']['GetMatchTimeline'](arg1);
+}
+
+export function GetProfiles() {
+  return window['go']['app']['App']['GetProfiles']();
+}
+
+export function GetRankProgression(arg1, arg2, arg3) {
+  return window['go']['app']['App']['GetRankProgression'](arg1, arg2, arg3);
+}
+
 export function GetRankedStats(arg1) {
   return window['go']['app']['App']['GetRankedStats'](arg1);
 }
 
+export function GetRateLimitState() {
+  return window['go']['app']['App']['GetRateLimitState']();
+}
+
+export function GetRegions() {
+  return window['go']['app']['App']['GetRegions']();
+}
+
+export function GetScoutingReport() {
+  return window['go']['app']['App']['GetScoutingReport']();
+}
+
+export function GetSecretBackend() {
+  return window['go']['app']['App']['GetSecretBackend']();
+}
+
+export function GetStoredMatches(arg1, arg2, arg3) {
+  return window['go']['app']['App']['GetStoredMatches'](arg1, arg2, arg3);
+}
+
 export function GetSummonerByID(arg1) {
   return window['go']['app']['App']['GetSummonerByID'](arg1);
 }
@@ -54,10 +150,46 @@ export function IsAutoAcceptRunning() {
   return window['go']['app']['App']['IsAutoAcceptRunning']();
 }
 
+export function IsChampSelectRunning() {
+  return window['go']['app']['App']['IsChampSelectRunning']();
+}
+
 export function IsConfigured() {
   return window['go']['app']['App']['IsConfigured']();
 }
 
+export function ListItemSetPresets() {
+  return window['go']['app']['App']['ListItemSetPresets']();
+}
+
+export function ListRunePresets() {
+  return window['go']['app']['App']['ListRunePresets']();
+}
+
+export function ListSpellPresets() {
+  return window['go']['app']['App']['ListSpellPresets']();
+}
+
+export function ListTrackedAccounts() {
+  return window['go']['app']['App']['ListTrackedAccounts']();
+}
+
+export function SaveCurrentRunePage(arg1, arg2) {
+  return window['go']['app']['App']['SaveCurrentRunePage'](arg1, arg2);
+}
+
+export function SaveItemSetPreset(arg1) {
+  return window['go']['app']['App']['SaveItemSetPreset'](arg1);
+}
+
+export function SaveRunePreset(arg1) {
+  return window['go']['app']['App']['SaveRunePreset'](arg1);
+}
+
+export function SaveSpellPreset(arg1) {
+  return window['go']['app']['App']['SaveSpellPreset'](arg1);
+}
+
 export function SearchSummoner(arg1) {
   return window['go']['app']['App']['SearchSummoner'](arg1);
 }
@@ -70,14 +202,46 @@ export function SetRegion(arg1) {
   return window['go']['app']['App']['SetRegion'](arg1);
 }
 
+export function SetSpellSlotOrder(arg1) {
+  return window['go']['app']['App']['SetSpellSlotOrder'](arg1);
+}
+
 export function StartAutoAccept(arg1) {
   return window['go']['app']['App']['StartAutoAccept'](arg1);
 }
 
+export function StartChampSelect(arg1) {
+  return window['go']['app']['App']['StartChampSelect'](arg1);
+}
+
 export function StopAutoAccept() {
   return window['go']['app']['App']['StopAutoAccept']();
 }
 
+export function StopChampSelect() {
+  return window['go']['app']['App']['StopChampSelect']();
+}
+
+export function SwitchProfile(arg1) {
+  return window['go']['app']['App']['SwitchProfile'](arg1);
+}
+
+export function SyncMatchHistory() {
+  return window['go']['app']['App']['SyncMatchHistory']();
+}
+
+export function TrackAccount(arg1) {
+  return window['go']['app']['App']['TrackAccount'](arg1);
+}
+
+export function UntrackAccount(arg1) {
+  return window['go']['app']['App']['UntrackAccount'](arg1);
+}
+
 export function UpdateAutoAcceptConfig(arg1) {
   return window['go']['app']['App']['UpdateAutoAcceptConfig'](arg1);
 }
+
+export function UpdateChampSelectConfig(arg1) {
+  return window['go']['app']['App']['UpdateChampSelectConfig'](arg1);
+}
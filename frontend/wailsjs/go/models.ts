@@ -14,10 +14,30 @@ export namespace app {
 	        this.autoAccept = source["autoAccept"];
 	    }
 	}
+	export class ChampSelectConfig {
+	    autoPick: boolean;
+	    autoBan: boolean;
+	    pickPriority: Record<string, Array<number>>;
+	    banPriority: Record<string, Array<number>>;
+	    lockInDelayMs: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ChampSelectConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.autoPick = source["autoPick"];
+	        this.autoBan = source["autoBan"];
+	        this.pickPriority = source["pickPriority"];
+	        this.banPriority = source["banPriority"];
+	        this.lockInDelayMs = source["lockInDelayMs"];
+	    }
+	}
 	export class LCUStatus {
 	    connected: boolean;
+	    state: string;
 	    port?: string;
-	    authToken?: string;
 	    error?: string;
 	
 	    static createFrom(source: any = {}) {
@@ -27,8 +47,8 @@ export namespace app {
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.connected = source["connected"];
+	        this.state = source["state"];
 	        this.port = source["port"];
-	        this.authToken = source["authToken"];
 	        this.error = source["error"];
 	    }
 	}
@@ -37,9 +57,50 @@ export namespace app {
 
 export namespace config {
 	
+	export class LCUConfig {
+	    discovery: string[];
+	    league_path?: string;
+	    host?: string;
+	    port?: string;
+	    auth_token?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LCUConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.discovery = source["discovery"];
+	        this.league_path = source["league_path"];
+	        this.host = source["host"];
+	        this.port = source["port"];
+	        this.auth_token = source["auth_token"];
+	    }
+	}
+	export class Profile {
+	    name: string;
+	    api_key_ref?: string;
+	    region: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Profile(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.api_key_ref = source["api_key_ref"];
+	        this.region = source["region"];
+	    }
+	}
 	export class Config {
-	    riot_api_key: string;
+	    version: number;
 	    region: string;
+	    profiles?: Profile[];
+	    active_profile?: string;
+	    lcu: LCUConfig;
+	    spell_slot_order?: string;
+	    disk_cache?: boolean;
 	
 	    static createFrom(source: any = {}) {
 	        return new Config(source);
@@ -47,71 +108,87 @@ export namespace config {
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.riot_api_key = source["riot_api_key"];
+	        this.version = source["version"];
 	        this.region = source["region"];
+	        this.profiles = this.convertValues(source["profiles"], Profile);
+	        this.active_profile = source["active_profile"];
+	        this.lcu = this.convertValues(source["lcu"], LCUConfig);
+	        this.spell_slot_order = source["spell_slot_order"];
+	        this.disk_cache = source["disk_cache"];
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-
-}
-
-export namespace lcu {
+	export class Issue {
+	    field: string;
+	    message: string;
+	    source: string;
 	
-	export class RerollPoints {
-	    currentPoints: number;
-	    maxRolls: number;
-	    numberOfRolls: number;
-	    pointsCostToRoll: number;
-	    pointsToReroll: number;
+	    static createFrom(source: any = {}) {
+	        return new Issue(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.field = source["field"];
+	        this.message = source["message"];
+	        this.source = source["source"];
+	    }
+	}
+	export class DoctorValue {
+	    key: string;
+	    value: string;
+	    source: string;
+	    env: string;
+	    flag: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new RerollPoints(source);
+	        return new DoctorValue(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.currentPoints = source["currentPoints"];
-	        this.maxRolls = source["maxRolls"];
-	        this.numberOfRolls = source["numberOfRolls"];
-	        this.pointsCostToRoll = source["pointsCostToRoll"];
-	        this.pointsToReroll = source["pointsToReroll"];
+	        this.key = source["key"];
+	        this.value = source["value"];
+	        this.source = source["source"];
+	        this.env = source["env"];
+	        this.flag = source["flag"];
 	    }
 	}
-	export class CurrentSummoner {
-	    accountId: number;
-	    displayName: string;
-	    gameName: string;
-	    tagLine: string;
-	    internalName: string;
-	    nameChangeFlag: boolean;
-	    percentCompleteForNextLevel: number;
-	    profileIconId: number;
-	    puuid: string;
-	    rerollPoints: RerollPoints;
-	    summonerId: number;
-	    summonerLevel: number;
-	    xpSinceLastLevel: number;
-	    xpUntilNextLevel: number;
+	export class DoctorReport {
+	    path: string;
+	    version: number;
+	    secretBackend: string;
+	    values: DoctorValue[];
+	    issues: Issue[];
 	
 	    static createFrom(source: any = {}) {
-	        return new CurrentSummoner(source);
+	        return new DoctorReport(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.accountId = source["accountId"];
-	        this.displayName = source["displayName"];
-	        this.gameName = source["gameName"];
-	        this.tagLine = source["tagLine"];
-	        this.internalName = source["internalName"];
-	        this.nameChangeFlag = source["nameChangeFlag"];
-	        this.percentCompleteForNextLevel = source["percentCompleteForNextLevel"];
-	        this.profileIconId = source["profileIconId"];
-	        this.puuid = source["puuid"];
-	        this.rerollPoints = this.convertValues(source["rerollPoints"], RerollPoints);
-	        this.summonerId = source["summonerId"];
-	        this.summonerLevel = source["summonerLevel"];
-	        this.xpSinceLastLevel = source["xpSinceLastLevel"];
-	        this.xpUntilNextLevel = source["xpUntilNextLevel"];
+	        this.path = source["path"];
+	        this.version = source["version"];
+	        this.secretBackend = source["secretBackend"];
+	        this.values = this.convertValues(source["values"], DoctorValue);
+	        this.issues = this.convertValues(source["issues"], Issue);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -132,93 +209,166 @@ export namespace lcu {
 		    return a;
 		}
 	}
+	
+	
+	
+	
+	export class ProfileInfo {
+	    name: string;
+	    region: string;
+	    hasApiKey: boolean;
+	    apiKeyHint: string;
+	    active: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProfileInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.region = source["region"];
+	        this.hasApiKey = source["hasApiKey"];
+	        this.apiKeyHint = source["apiKeyHint"];
+	        this.active = source["active"];
+	    }
+	}
 
 }
 
-export namespace lol {
+export namespace history {
 	
-	export class ChampionMasteryInfo {
+	export class ChampionStats {
 	    championId: number;
-	    championLevel: number;
-	    championPoints: number;
-	    championPointsSinceLastLevel: number;
-	    championPointsUntilNextLevel: number;
-	    chestGranted: boolean;
-	    lastPlayTime: number;
-	    tokensEarned: number;
-	    summonerId: string;
+	    championName: string;
+	    games: number;
+	    wins: number;
+	    winRate: number;
+	    kda: number;
+	    csPerMin: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new ChampionMasteryInfo(source);
+	        return new ChampionStats(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.championId = source["championId"];
-	        this.championLevel = source["championLevel"];
-	        this.championPoints = source["championPoints"];
-	        this.championPointsSinceLastLevel = source["championPointsSinceLastLevel"];
-	        this.championPointsUntilNextLevel = source["championPointsUntilNextLevel"];
-	        this.chestGranted = source["chestGranted"];
-	        this.lastPlayTime = source["lastPlayTime"];
-	        this.tokensEarned = source["tokensEarned"];
-	        this.summonerId = source["summonerId"];
+	        this.championName = source["championName"];
+	        this.games = source["games"];
+	        this.wins = source["wins"];
+	        this.winRate = source["winRate"];
+	        this.kda = source["kda"];
+	        this.csPerMin = source["csPerMin"];
 	    }
 	}
-	export class RankedInfo {
-	    queueType: string;
+	export class AccountStats {
+	    games: number;
+	    wins: number;
+	    winRate: number;
+	    kda: number;
+	    csPerMin: number;
+	    champions: ChampionStats[];
+	
+	    static createFrom(source: any = {}) {
+	        return new AccountStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.games = source["games"];
+	        this.wins = source["wins"];
+	        this.winRate = source["winRate"];
+	        this.kda = source["kda"];
+	        this.csPerMin = source["csPerMin"];
+	        this.champions = this.convertValues(source["champions"], ChampionStats);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class RankChange {
+	    timestamp: number;
+	    games: number;
+	    wins: number;
+	    lpDelta: number;
+	    event?: string;
+	    fromTier: string;
+	    fromRank: string;
+	    toTier: string;
+	    toRank: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RankChange(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.timestamp = source["timestamp"];
+	        this.games = source["games"];
+	        this.wins = source["wins"];
+	        this.lpDelta = source["lpDelta"];
+	        this.event = source["event"];
+	        this.fromTier = source["fromTier"];
+	        this.fromRank = source["fromRank"];
+	        this.toTier = source["toTier"];
+	        this.toRank = source["toRank"];
+	    }
+	}
+	export class RankPoint {
+	    timestamp: number;
 	    tier: string;
 	    rank: string;
 	    leaguePoints: number;
 	    wins: number;
 	    losses: number;
-	    hotStreak: boolean;
-	    veteran: boolean;
-	    freshBlood: boolean;
-	    inactive: boolean;
-	    summonerId: string;
-	    summonerName: string;
-	    puuid: string;
+	    absoluteLp: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new RankedInfo(source);
+	        return new RankPoint(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.queueType = source["queueType"];
+	        this.timestamp = source["timestamp"];
 	        this.tier = source["tier"];
 	        this.rank = source["rank"];
 	        this.leaguePoints = source["leaguePoints"];
 	        this.wins = source["wins"];
 	        this.losses = source["losses"];
-	        this.hotStreak = source["hotStreak"];
-	        this.veteran = source["veteran"];
-	        this.freshBlood = source["freshBlood"];
-	        this.inactive = source["inactive"];
-	        this.summonerId = source["summonerId"];
-	        this.summonerName = source["summonerName"];
-	        this.puuid = source["puuid"];
+	        this.absoluteLp = source["absoluteLp"];
 	    }
 	}
-	export class LeagueListInfo {
-	    tier: string;
-	    leagueId: string;
-	    queue: string;
-	    name: string;
-	    entries: RankedInfo[];
+	export class RankProgression {
+	    queueType: string;
+	    points: RankPoint[];
+	    changes: RankChange[];
 	
 	    static createFrom(source: any = {}) {
-	        return new LeagueListInfo(source);
+	        return new RankProgression(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.tier = source["tier"];
-	        this.leagueId = source["leagueId"];
-	        this.queue = source["queue"];
-	        this.name = source["name"];
-	        this.entries = this.convertValues(source["entries"], RankedInfo);
+	        this.queueType = source["queueType"];
+	        this.points = this.convertValues(source["points"], RankPoint);
+	        this.changes = this.convertValues(source["changes"], RankChange);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -239,32 +389,1493 @@ export namespace lol {
 		    return a;
 		}
 	}
+	export class SyncResult {
+	    puuid: string;
+	    newMatches: number;
+	    error?: string;
 	
-	export class SummonerInfo {
-	    id: string;
-	    accountId: string;
+	    static createFrom(source: any = {}) {
+	        return new SyncResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.puuid = source["puuid"];
+	        this.newMatches = source["newMatches"];
+	        this.error = source["error"];
+	    }
+	}
+	export class TrackedAccount {
 	    puuid: string;
 	    gameName: string;
 	    tagLine: string;
-	    profileIconId: number;
-	    summonerLevel: number;
-	    revisionDate: number;
+	    lastSyncedAt: number;
+	    lastSyncedCreation: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new SummonerInfo(source);
+	        return new TrackedAccount(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.accountId = source["accountId"];
 	        this.puuid = source["puuid"];
 	        this.gameName = source["gameName"];
 	        this.tagLine = source["tagLine"];
-	        this.profileIconId = source["profileIconId"];
-	        this.summonerLevel = source["summonerLevel"];
-	        this.revisionDate = source["revisionDate"];
+	        this.lastSyncedAt = source["lastSyncedAt"];
+	        this.lastSyncedCreation = source["lastSyncedCreation"];
+	    }
+	}
+
+}
+
+export namespace lcu {
+	
+	export class RerollPoints {
+	    currentPoints: number;
+	    maxRolls: number;
+	    numberOfRolls: number;
+	    pointsCostToRoll: number;
+	    pointsToReroll: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RerollPoints(source);
 	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.currentPoints = source["currentPoints"];
+	        this.maxRolls = source["maxRolls"];
+	        this.numberOfRolls = source["numberOfRolls"];
+	        this.pointsCostToRoll = source["pointsCostToRoll"];
+	        this.pointsToReroll = source["pointsToReroll"];
+	    }
+	}
+	export class CurrentSummoner {
+	    accountId: number;
+	    displayName: string;
+	    gameName: string;
+	    tagLine: string;
+	    internalName: string;
+	    nameChangeFlag: boolean;
+	    percentCompleteForNextLevel: number;
+	    profileIconId: number;
+	    puuid: string;
+	    rerollPoints: RerollPoints;
+	    summonerId: number;
+	    summonerLevel: number;
+	    xpSinceLastLevel: number;
+	    xpUntilNextLevel: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CurrentSummoner(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accountId = source["accountId"];
+	        this.displayName = source["displayName"];
+	        this.gameName = source["gameName"];
+	        this.tagLine = source["tagLine"];
+	        this.internalName = source["internalName"];
+	        this.nameChangeFlag = source["nameChangeFlag"];
+	        this.percentCompleteForNextLevel = source["percentCompleteForNextLevel"];
+	        this.profileIconId = source["profileIconId"];
+	        this.puuid = source["puuid"];
+	        this.rerollPoints = this.convertValues(source["rerollPoints"], RerollPoints);
+	        this.summonerId = source["summonerId"];
+	        this.summonerLevel = source["summonerLevel"];
+	        this.xpSinceLastLevel = source["xpSinceLastLevel"];
+	        this.xpUntilNextLevel = source["xpUntilNextLevel"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+
+}
+
+export namespace liveclient {
+	
+	export class ChampionStats {
+	    abilityPower: number;
+	    attackDamage: number;
+	    attackSpeed: number;
+	    armor: number;
+	    magicResist: number;
+	    currentHealth: number;
+	    maxHealth: number;
+	    moveSpeed: number;
+	    resourceValue: number;
+	    resourceMax: number;
+	    resourceType: string;
+	    abilityHaste: number;
+	    critChance: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ChampionStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.abilityPower = source["abilityPower"];
+	        this.attackDamage = source["attackDamage"];
+	        this.attackSpeed = source["attackSpeed"];
+	        this.armor = source["armor"];
+	        this.magicResist = source["magicResist"];
+	        this.currentHealth = source["currentHealth"];
+	        this.maxHealth = source["maxHealth"];
+	        this.moveSpeed = source["moveSpeed"];
+	        this.resourceValue = source["resourceValue"];
+	        this.resourceMax = source["resourceMax"];
+	        this.resourceType = source["resourceType"];
+	        this.abilityHaste = source["abilityHaste"];
+	        this.critChance = source["critChance"];
+	    }
+	}
+	export class ActivePlayer {
+	    riotId: string;
+	    summonerName: string;
+	    level: number;
+	    currentGold: number;
+	    championStats: ChampionStats;
+	
+	    static createFrom(source: any = {}) {
+	        return new ActivePlayer(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.riotId = source["riotId"];
+	        this.summonerName = source["summonerName"];
+	        this.level = source["level"];
+	        this.currentGold = source["currentGold"];
+	        this.championStats = this.convertValues(source["championStats"], ChampionStats);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class Event {
+	    EventID: number;
+	    EventName: string;
+	    EventTime: number;
+	    KillerName?: string;
+	    VictimName?: string;
+	    Assisters?: string[];
+	    Recipient?: string;
+	    KillStreak?: number;
+	    Acer?: string;
+	    AcingTeam?: string;
+	    DragonType?: string;
+	    Stolen?: string;
+	    TurretKilled?: string;
+	    InhibKilled?: string;
+	    Result?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Event(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.EventID = source["EventID"];
+	        this.EventName = source["EventName"];
+	        this.EventTime = source["EventTime"];
+	        this.KillerName = source["KillerName"];
+	        this.VictimName = source["VictimName"];
+	        this.Assisters = source["Assisters"];
+	        this.Recipient = source["Recipient"];
+	        this.KillStreak = source["KillStreak"];
+	        this.Acer = source["Acer"];
+	        this.AcingTeam = source["AcingTeam"];
+	        this.DragonType = source["DragonType"];
+	        this.Stolen = source["Stolen"];
+	        this.TurretKilled = source["TurretKilled"];
+	        this.InhibKilled = source["InhibKilled"];
+	        this.Result = source["Result"];
+	    }
+	}
+	export class Item {
+	    itemID: number;
+	    displayName: string;
+	    count: number;
+	    price: number;
+	    slot: number;
+	    consumable: boolean;
+	    canUse: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new Item(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.itemID = source["itemID"];
+	        this.displayName = source["displayName"];
+	        this.count = source["count"];
+	        this.price = source["price"];
+	        this.slot = source["slot"];
+	        this.consumable = source["consumable"];
+	        this.canUse = source["canUse"];
+	    }
+	}
+	export class SummonerSpell {
+	    displayName: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SummonerSpell(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.displayName = source["displayName"];
+	    }
+	}
+	export class SummonerSpells {
+	    summonerSpellOne: SummonerSpell;
+	    summonerSpellTwo: SummonerSpell;
+	
+	    static createFrom(source: any = {}) {
+	        return new SummonerSpells(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.summonerSpellOne = this.convertValues(source["summonerSpellOne"], SummonerSpell);
+	        this.summonerSpellTwo = this.convertValues(source["summonerSpellTwo"], SummonerSpell);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Scores {
+	    kills: number;
+	    deaths: number;
+	    assists: number;
+	    creepScore: number;
+	    wardScore: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Scores(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kills = source["kills"];
+	        this.deaths = source["deaths"];
+	        this.assists = source["assists"];
+	        this.creepScore = source["creepScore"];
+	        this.wardScore = source["wardScore"];
+	    }
+	}
+	export class Player {
+	    riotId: string;
+	    riotIdGameName: string;
+	    riotIdTagLine: string;
+	    summonerName: string;
+	    championName: string;
+	    rawChampionName: string;
+	    team: string;
+	    position: string;
+	    level: number;
+	    isBot: boolean;
+	    isDead: boolean;
+	    respawnTimer: number;
+	    items: Item[];
+	    scores: Scores;
+	    summonerSpells: SummonerSpells;
+	
+	    static createFrom(source: any = {}) {
+	        return new Player(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.riotId = source["riotId"];
+	        this.riotIdGameName = source["riotIdGameName"];
+	        this.riotIdTagLine = source["riotIdTagLine"];
+	        this.summonerName = source["summonerName"];
+	        this.championName = source["championName"];
+	        this.rawChampionName = source["rawChampionName"];
+	        this.team = source["team"];
+	        this.position = source["position"];
+	        this.level = source["level"];
+	        this.isBot = source["isBot"];
+	        this.isDead = source["isDead"];
+	        this.respawnTimer = source["respawnTimer"];
+	        this.items = this.convertValues(source["items"], Item);
+	        this.scores = this.convertValues(source["scores"], Scores);
+	        this.summonerSpells = this.convertValues(source["summonerSpells"], SummonerSpells);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class TeamSummary {
+	    team: string;
+	    kills: number;
+	    deaths: number;
+	    assists: number;
+	    creepScore: number;
+	    itemGold: number;
+	    turrets: number;
+	    inhibitors: number;
+	    dragons: number;
+	    heralds: number;
+	    barons: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new TeamSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.team = source["team"];
+	        this.kills = source["kills"];
+	        this.deaths = source["deaths"];
+	        this.assists = source["assists"];
+	        this.creepScore = source["creepScore"];
+	        this.itemGold = source["itemGold"];
+	        this.turrets = source["turrets"];
+	        this.inhibitors = source["inhibitors"];
+	        this.dragons = source["dragons"];
+	        this.heralds = source["heralds"];
+	        this.barons = source["barons"];
+	    }
+	}
+	export class Snapshot {
+	    gameTime: number;
+	    gameMode: string;
+	    activePlayer?: ActivePlayer;
+	    activeTeam?: string;
+	    teams: TeamSummary[];
+	    players: Player[];
+	    goldDiff: number;
+	    events: Event[];
+	
+	    static createFrom(source: any = {}) {
+	        return new Snapshot(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.gameTime = source["gameTime"];
+	        this.gameMode = source["gameMode"];
+	        this.activePlayer = this.convertValues(source["activePlayer"], ActivePlayer);
+	        this.activeTeam = source["activeTeam"];
+	        this.teams = this.convertValues(source["teams"], TeamSummary);
+	        this.players = this.convertValues(source["players"], Player);
+	        this.goldDiff = source["goldDiff"];
+	        this.events = this.convertValues(source["events"], Event);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+
+}
+
+export namespace lol {
+	
+	export class RankedInfo {
+	    queueType: string;
+	    tier: string;
+	    rank: string;
+	    leaguePoints: number;
+	    wins: number;
+	    losses: number;
+	    hotStreak: boolean;
+	    veteran: boolean;
+	    freshBlood: boolean;
+	    inactive: boolean;
+	    summonerId: string;
+	    summonerName: string;
+	    puuid: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RankedInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.queueType = source["queueType"];
+	        this.tier = source["tier"];
+	        this.rank = source["rank"];
+	        this.leaguePoints = source["leaguePoints"];
+	        this.wins = source["wins"];
+	        this.losses = source["losses"];
+	        this.hotStreak = source["hotStreak"];
+	        this.veteran = source["veteran"];
+	        this.freshBlood = source["freshBlood"];
+	        this.inactive = source["inactive"];
+	        this.summonerId = source["summonerId"];
+	        this.summonerName = source["summonerName"];
+	        this.puuid = source["puuid"];
+	    }
+	}
+	export class ActiveParticipant {
+	    puuid: string;
+	    summonerId: string;
+	    gameName: string;
+	    tagLine: string;
+	    championId: number;
+	    spell1Id: number;
+	    spell2Id: number;
+	    profileIconId: number;
+	    bot: boolean;
+	    ranked: RankedInfo[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ActiveParticipant(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.puuid = source["puuid"];
+	        this.summonerId = source["summonerId"];
+	        this.gameName = source["gameName"];
+	        this.tagLine = source["tagLine"];
+	        this.championId = source["championId"];
+	        this.spell1Id = source["spell1Id"];
+	        this.spell2Id = source["spell2Id"];
+	        this.profileIconId = source["profileIconId"];
+	        this.bot = source["bot"];
+	        this.ranked = this.convertValues(source["ranked"], RankedInfo);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ActiveTeam {
+	    teamId: number;
+	    participants: ActiveParticipant[];
+	    bans: number[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ActiveTeam(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.teamId = source["teamId"];
+	        this.participants = this.convertValues(source["participants"], ActiveParticipant);
+	        this.bans = source["bans"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ActiveGame {
+	    gameId: number;
+	    gameMode: string;
+	    gameType: string;
+	    queueId: number;
+	    mapId: number;
+	    gameStartTime: number;
+	    elapsedSeconds: number;
+	    teams: ActiveTeam[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ActiveGame(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.gameId = source["gameId"];
+	        this.gameMode = source["gameMode"];
+	        this.gameType = source["gameType"];
+	        this.queueId = source["queueId"];
+	        this.mapId = source["mapId"];
+	        this.gameStartTime = source["gameStartTime"];
+	        this.elapsedSeconds = source["elapsedSeconds"];
+	        this.teams = this.convertValues(source["teams"], ActiveTeam);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class ChampionMasteryInfo {
+	    championId: number;
+	    championLevel: number;
+	    championPoints: number;
+	    championPointsSinceLastLevel: number;
+	    championPointsUntilNextLevel: number;
+	    chestGranted: boolean;
+	    lastPlayTime: number;
+	    tokensEarned: number;
+	    summonerId: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ChampionMasteryInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.championId = source["championId"];
+	        this.championLevel = source["championLevel"];
+	        this.championPoints = source["championPoints"];
+	        this.championPointsSinceLastLevel = source["championPointsSinceLastLevel"];
+	        this.championPointsUntilNextLevel = source["championPointsUntilNextLevel"];
+	        this.chestGranted = source["chestGranted"];
+	        this.lastPlayTime = source["lastPlayTime"];
+	        this.tokensEarned = source["tokensEarned"];
+	        this.summonerId = source["summonerId"];
+	    }
+	}
+	export class LadderEntry {
+	    position: number;
+	    queueType: string;
+	    tier: string;
+	    rank: string;
+	    leaguePoints: number;
+	    wins: number;
+	    losses: number;
+	    hotStreak: boolean;
+	    veteran: boolean;
+	    freshBlood: boolean;
+	    inactive: boolean;
+	    summonerId: string;
+	    summonerName: string;
+	    puuid: string;
+	    gameName: string;
+	    tagLine: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LadderEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.position = source["position"];
+	        this.queueType = source["queueType"];
+	        this.tier = source["tier"];
+	        this.rank = source["rank"];
+	        this.leaguePoints = source["leaguePoints"];
+	        this.wins = source["wins"];
+	        this.losses = source["losses"];
+	        this.hotStreak = source["hotStreak"];
+	        this.veteran = source["veteran"];
+	        this.freshBlood = source["freshBlood"];
+	        this.inactive = source["inactive"];
+	        this.summonerId = source["summonerId"];
+	        this.summonerName = source["summonerName"];
+	        this.puuid = source["puuid"];
+	        this.gameName = source["gameName"];
+	        this.tagLine = source["tagLine"];
+	    }
+	}
+	export class Ladder {
+	    queue: string;
+	    tier: string;
+	    entries: LadderEntry[];
+	    partial: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new Ladder(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.queue = source["queue"];
+	        this.tier = source["tier"];
+	        this.entries = this.convertValues(source["entries"], LadderEntry);
+	        this.partial = source["partial"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class LeagueListInfo {
+	    tier: string;
+	    leagueId: string;
+	    queue: string;
+	    name: string;
+	    entries: RankedInfo[];
+	
+	    static createFrom(source: any = {}) {
+	        return new LeagueListInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.tier = source["tier"];
+	        this.leagueId = source["leagueId"];
+	        this.queue = source["queue"];
+	        this.name = source["name"];
+	        this.entries = this.convertValues(source["entries"], RankedInfo);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class TimelineEvent {
+	    type: string;
+	    timestamp: number;
+	    participantId?: number;
+	    killerId?: number;
+	    victimId?: number;
+	    assistingParticipantIds?: number[];
+	    teamId?: number;
+	    killerTeamId?: number;
+	    monsterType?: string;
+	    monsterSubType?: string;
+	    buildingType?: string;
+	    towerType?: string;
+	    laneType?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new TimelineEvent(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.type = source["type"];
+	        this.timestamp = source["timestamp"];
+	        this.participantId = source["participantId"];
+	        this.killerId = source["killerId"];
+	        this.victimId = source["victimId"];
+	        this.assistingParticipantIds = source["assistingParticipantIds"];
+	        this.teamId = source["teamId"];
+	        this.killerTeamId = source["killerTeamId"];
+	        this.monsterType = source["monsterType"];
+	        this.monsterSubType = source["monsterSubType"];
+	        this.buildingType = source["buildingType"];
+	        this.towerType = source["towerType"];
+	        this.laneType = source["laneType"];
+	    }
+	}
+	export class ParticipantAnalysis {
+	    participantId: number;
+	    puuid: string;
+	    championId: number;
+	    teamId: number;
+	    position: string;
+	    gold: number[];
+	    xp: number[];
+	    cs: number[];
+	    opponentId: number;
+	    goldDiffAt10?: number;
+	    goldDiffAt15?: number;
+	    firstBlood: boolean;
+	    firstTower: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ParticipantAnalysis(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.participantId = source["participantId"];
+	        this.puuid = source["puuid"];
+	        this.championId = source["championId"];
+	        this.teamId = source["teamId"];
+	        this.position = source["position"];
+	        this.gold = source["gold"];
+	        this.xp = source["xp"];
+	        this.cs = source["cs"];
+	        this.opponentId = source["opponentId"];
+	        this.goldDiffAt10 = source["goldDiffAt10"];
+	        this.goldDiffAt15 = source["goldDiffAt15"];
+	        this.firstBlood = source["firstBlood"];
+	        this.firstTower = source["firstTower"];
+	    }
+	}
+	export class MatchAnalysis {
+	    matchId: string;
+	    participants: ParticipantAnalysis[];
+	    kills: TimelineEvent[];
+	    objectives: TimelineEvent[];
+	
+	    static createFrom(source: any = {}) {
+	        return new MatchAnalysis(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.matchId = source["matchId"];
+	        this.participants = this.convertValues(source["participants"], ParticipantAnalysis);
+	        this.kills = this.convertValues(source["kills"], TimelineEvent);
+	        this.objectives = this.convertValues(source["objectives"], TimelineEvent);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MatchFilters {
+	    queue: number;
+	    type: string;
+	    start: number;
+	    count: number;
+	    startTime: number;
+	    endTime: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new MatchFilters(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.queue = source["queue"];
+	        this.type = source["type"];
+	        this.start = source["start"];
+	        this.count = source["count"];
+	        this.startTime = source["startTime"];
+	        this.endTime = source["endTime"];
+	    }
+	}
+	export class MatchRunes {
+	    primaryStyleId: number;
+	    subStyleId: number;
+	    perkIds: number[];
+	    statPerks: number[];
+	
+	    static createFrom(source: any = {}) {
+	        return new MatchRunes(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.primaryStyleId = source["primaryStyleId"];
+	        this.subStyleId = source["subStyleId"];
+	        this.perkIds = source["perkIds"];
+	        this.statPerks = source["statPerks"];
+	    }
+	}
+	export class MatchParticipant {
+	    puuid: string;
+	    gameName: string;
+	    tagLine: string;
+	    teamId: number;
+	    position: string;
+	    championId: number;
+	    championName: string;
+	    champLevel: number;
+	    kills: number;
+	    deaths: number;
+	    assists: number;
+	    kda: number;
+	    cs: number;
+	    goldEarned: number;
+	    damageDealt: number;
+	    damageTaken: number;
+	    visionScore: number;
+	    items: number[];
+	    spell1Id: number;
+	    spell2Id: number;
+	    runes?: MatchRunes;
+	    win: boolean;
+	    earlySurrender: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new MatchParticipant(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.puuid = source["puuid"];
+	        this.gameName = source["gameName"];
+	        this.tagLine = source["tagLine"];
+	        this.teamId = source["teamId"];
+	        this.position = source["position"];
+	        this.championId = source["championId"];
+	        this.championName = source["championName"];
+	        this.champLevel = source["champLevel"];
+	        this.kills = source["kills"];
+	        this.deaths = source["deaths"];
+	        this.assists = source["assists"];
+	        this.kda = source["kda"];
+	        this.cs = source["cs"];
+	        this.goldEarned = source["goldEarned"];
+	        this.damageDealt = source["damageDealt"];
+	        this.damageTaken = source["damageTaken"];
+	        this.visionScore = source["visionScore"];
+	        this.items = source["items"];
+	        this.spell1Id = source["spell1Id"];
+	        this.spell2Id = source["spell2Id"];
+	        this.runes = this.convertValues(source["runes"], MatchRunes);
+	        this.win = source["win"];
+	        this.earlySurrender = source["earlySurrender"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MatchTeam {
+	    teamId: number;
+	    win: boolean;
+	    bans: number[];
+	
+	    static createFrom(source: any = {}) {
+	        return new MatchTeam(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.teamId = source["teamId"];
+	        this.win = source["win"];
+	        this.bans = source["bans"];
+	    }
+	}
+	export class MatchInfo {
+	    matchId: string;
+	    gameCreation: number;
+	    gameDuration: number;
+	    gameMode: string;
+	    gameVersion: string;
+	    queueId: number;
+	    mapId: number;
+	    teams: MatchTeam[];
+	    participants: MatchParticipant[];
+	
+	    static createFrom(source: any = {}) {
+	        return new MatchInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.matchId = source["matchId"];
+	        this.gameCreation = source["gameCreation"];
+	        this.gameDuration = source["gameDuration"];
+	        this.gameMode = source["gameMode"];
+	        this.gameVersion = source["gameVersion"];
+	        this.queueId = source["queueId"];
+	        this.mapId = source["mapId"];
+	        this.teams = this.convertValues(source["teams"], MatchTeam);
+	        this.participants = this.convertValues(source["participants"], MatchParticipant);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	
+	export class ParticipantFrame {
+	    participantId: number;
+	    level: number;
+	    xp: number;
+	    currentGold: number;
+	    totalGold: number;
+	    minionsKilled: number;
+	    jungleMinionsKilled: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ParticipantFrame(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.participantId = source["participantId"];
+	        this.level = source["level"];
+	        this.xp = source["xp"];
+	        this.currentGold = source["currentGold"];
+	        this.totalGold = source["totalGold"];
+	        this.minionsKilled = source["minionsKilled"];
+	        this.jungleMinionsKilled = source["jungleMinionsKilled"];
+	    }
+	}
+	export class TimelineFrame {
+	    timestamp: number;
+	    participantFrames: Record<string, ParticipantFrame>;
+	    events: TimelineEvent[];
+	
+	    static createFrom(source: any = {}) {
+	        return new TimelineFrame(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.timestamp = source["timestamp"];
+	        this.participantFrames = this.convertValues(source["participantFrames"], ParticipantFrame, true);
+	        this.events = this.convertValues(source["events"], TimelineEvent);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class TimelineParticipant {
+	    participantId: number;
+	    puuid: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new TimelineParticipant(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.participantId = source["participantId"];
+	        this.puuid = source["puuid"];
+	    }
+	}
+	export class MatchTimeline {
+	    matchId: string;
+	    frameInterval: number;
+	    participants: TimelineParticipant[];
+	    frames: TimelineFrame[];
+	
+	    static createFrom(source: any = {}) {
+	        return new MatchTimeline(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.matchId = source["matchId"];
+	        this.frameInterval = source["frameInterval"];
+	        this.participants = this.convertValues(source["participants"], TimelineParticipant);
+	        this.frames = this.convertValues(source["frames"], TimelineFrame);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class Platform {
+	    id: string;
+	    name: string;
+	    cluster: string;
+	    accountCluster: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Platform(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.cluster = source["cluster"];
+	        this.accountCluster = source["accountCluster"];
+	    }
+	}
+	
+	export class RateLimitWindow {
+	    limit: number;
+	    used: number;
+	    windowSeconds: number;
+	    resetsInMs: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RateLimitWindow(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.limit = source["limit"];
+	        this.used = source["used"];
+	        this.windowSeconds = source["windowSeconds"];
+	        this.resetsInMs = source["resetsInMs"];
+	    }
+	}
+	export class RateLimitState {
+	    host: string;
+	    method?: string;
+	    windows: RateLimitWindow[];
+	    blockedForMs: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RateLimitState(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.host = source["host"];
+	        this.method = source["method"];
+	        this.windows = this.convertValues(source["windows"], RateLimitWindow);
+	        this.blockedForMs = source["blockedForMs"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class SummonerInfo {
+	    id: string;
+	    accountId: string;
+	    puuid: string;
+	    gameName: string;
+	    tagLine: string;
+	    profileIconId: number;
+	    summonerLevel: number;
+	    revisionDate: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SummonerInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.accountId = source["accountId"];
+	        this.puuid = source["puuid"];
+	        this.gameName = source["gameName"];
+	        this.tagLine = source["tagLine"];
+	        this.profileIconId = source["profileIconId"];
+	        this.summonerLevel = source["summonerLevel"];
+	        this.revisionDate = source["revisionDate"];
+	    }
+	}
+	
+	
+
+}
+
+export namespace presets {
+	
+	export class ItemSlot {
+	    id: string;
+	    count: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ItemSlot(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.count = source["count"];
+	    }
+	}
+	export class ItemBlock {
+	    type: string;
+	    items: ItemSlot[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ItemBlock(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.type = source["type"];
+	        this.items = this.convertValues(source["items"], ItemSlot);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ItemSetPreset {
+	    championId: number;
+	    position: string;
+	    queueId: number;
+	    title: string;
+	    blocks: ItemBlock[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ItemSetPreset(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.championId = source["championId"];
+	        this.position = source["position"];
+	        this.queueId = source["queueId"];
+	        this.title = source["title"];
+	        this.blocks = this.convertValues(source["blocks"], ItemBlock);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class RunePreset {
+	    championId: number;
+	    position: string;
+	    name: string;
+	    primaryStyleId: number;
+	    subStyleId: number;
+	    selectedPerkIds: number[];
+	
+	    static createFrom(source: any = {}) {
+	        return new RunePreset(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.championId = source["championId"];
+	        this.position = source["position"];
+	        this.name = source["name"];
+	        this.primaryStyleId = source["primaryStyleId"];
+	        this.subStyleId = source["subStyleId"];
+	        this.selectedPerkIds = source["selectedPerkIds"];
+	    }
+	}
+	export class SpellPreset {
+	    championId: number;
+	    position: string;
+	    queueId: number;
+	    spell1Id: number;
+	    spell2Id: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SpellPreset(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.championId = source["championId"];
+	        this.position = source["position"];
+	        this.queueId = source["queueId"];
+	        this.spell1Id = source["spell1Id"];
+	        this.spell2Id = source["spell2Id"];
+	    }
+	}
+
+}
+
+export namespace scouting {
+	
+	export class RoleShare {
+	    position: string;
+	    games: number;
+	    share: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RoleShare(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.position = source["position"];
+	        this.games = source["games"];
+	        this.share = source["share"];
+	    }
+	}
+	export class Report {
+	    cellId: number;
+	    puuid: string;
+	    position: string;
+	    championId: number;
+	    isLocal: boolean;
+	    gameName: string;
+	    tagLine: string;
+	    summonerLevel: number;
+	    ranked: lol.RankedInfo[];
+	    recentGames: number;
+	    recentWins: number;
+	    recentWinRate: number;
+	    mainRoles: RoleShare[];
+	    mastery?: lol.ChampionMasteryInfo;
+	    hidden: boolean;
+	    error?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Report(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.cellId = source["cellId"];
+	        this.puuid = source["puuid"];
+	        this.position = source["position"];
+	        this.championId = source["championId"];
+	        this.isLocal = source["isLocal"];
+	        this.gameName = source["gameName"];
+	        this.tagLine = source["tagLine"];
+	        this.summonerLevel = source["summonerLevel"];
+	        this.ranked = this.convertValues(source["ranked"], lol.RankedInfo);
+	        this.recentGames = source["recentGames"];
+	        this.recentWins = source["recentWins"];
+	        this.recentWinRate = source["recentWinRate"];
+	        this.mainRoles = this.convertValues(source["mainRoles"], RoleShare);
+	        this.mastery = this.convertValues(source["mastery"], lol.ChampionMasteryInfo);
+	        this.hidden = source["hidden"];
+	        this.error = source["error"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
 
 }
@@ -0,0 +1,126 @@
+// Package apierr classifies errors from the LCU and Riot API clients into codes
+// the frontend can branch on, without matching on error messages.
+package apierr
+
+import (
+	"errors"
+	"net/http"
+
+	"lol-toolkit/internal/retry"
+)
+
+// Code identifies a class of failure.
+type Code string
+
+// Error codes reported to the frontend
+const (
+	CodeUnknown          Code = "unknown"
+	CodeNotConfigured    Code = "not_configured"     // no Riot API key set
+	CodeNotConnected     Code = "not_connected"      // League client connection lost
+	CodeClientNotRunning Code = "client_not_running" // no League client found
+	CodeNetwork          Code = "network"            // request never got a response
+	CodeBadRequest       Code = "bad_request"
+	CodeUnauthorized     Code = "unauthorized" // missing, invalid or expired credentials
+	CodeForbidden        Code = "forbidden"
+	CodeNotFound         Code = "not_found"
+	CodeRateLimited      Code = "rate_limited"
+	CodeUnavailable      Code = "unavailable" // server error, usually transient
+)
+
+// StatusError is implemented by errors that carry an HTTP status code.
+type StatusError interface {
+	error
+	StatusCode() int
+}
+
+// CodedError is implemented by errors that have a fixed code, such as sentinel errors.
+type CodedError interface {
+	error
+	ErrorCode() Code
+}
+
+// Error is a plain error with a code, used for sentinel errors.
+type Error struct {
+	Code    Code
+	Message string
+}
+
+// New creates an error with a code.
+func New(code Code, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// ErrorCode implements CodedError.
+func (e *Error) ErrorCode() Code {
+	return e.Code
+}
+
+// StatusOf returns the HTTP status carried by err, or 0 if there is none.
+func StatusOf(err error) int {
+	var statusErr StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode()
+	}
+	return 0
+}
+
+// CodeOf classifies err.
+func CodeOf(err error) Code {
+	if err == nil {
+		return ""
+	}
+
+	var coded CodedError
+	if errors.As(err, &coded) {
+		return coded.ErrorCode()
+	}
+	if status := StatusOf(err); status != 0 {
+		return CodeForStatus(status)
+	}
+	if retry.IsNetworkError(err) {
+		return CodeNetwork
+	}
+	return CodeUnknown
+}
+
+// CodeForStatus maps an HTTP error status to a code.
+func CodeForStatus(status int) Code {
+	switch {
+	case status == http.StatusBadRequest:
+		return CodeBadRequest
+	case status == http.StatusUnauthorized:
+		return CodeUnauthorized
+	case status == http.StatusForbidden:
+		return CodeForbidden
+	case status == http.StatusNotFound:
+		return CodeNotFound
+	case status == http.StatusTooManyRequests:
+		return CodeRateLimited
+	case status >= http.StatusInternalServerError:
+		return CodeUnavailable
+	default:
+		return CodeUnknown
+	}
+}
+
+// Payload is how an error returned by an App method reaches the frontend.
+type Payload struct {
+	Code    Code   `json:"code"`
+	Message string `json:"message"`
+	Status  int    `json:"status,omitempty"` // HTTP status, if the error came from a response
+}
+
+// Format converts an error for the frontend. It is used as the Wails error formatter,
+// so rejected promises carry a Payload instead of a bare string.
+func Format(err error) any {
+	return Payload{
+		Code:    CodeOf(err),
+		Message: err.Error(),
+		Status:  StatusOf(err),
+	}
+}
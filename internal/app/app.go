@@ -2,25 +2,68 @@ package app
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 
 	"github.com/wailsapp/wails/v2/pkg/runtime"
 
+	"lol-toolkit/internal/apierr"
+	"lol-toolkit/internal/cache"
 	"lol-toolkit/internal/config"
+	"lol-toolkit/internal/history"
 	"lol-toolkit/internal/lcu"
+	"lol-toolkit/internal/liveclient"
 	"lol-toolkit/internal/logger"
 	"lol-toolkit/internal/lol"
+	"lol-toolkit/internal/presets"
+	"lol-toolkit/internal/scouting"
 )
 
+// responseCacheSize is the number of Riot API responses kept in memory.
+const responseCacheSize = 2000
+
+// errNotConfigured is returned by Riot API methods until an API key is set.
+var errNotConfigured = apierr.New(apierr.CodeNotConfigured, "API client not initialized. Please set your API key first")
+
 // App holds application state and dependencies.
 type App struct {
-	ctx       context.Context
-	config    *config.Config
-	lolClient *lol.Client
+	ctx        context.Context
+	args       []string // command line arguments, which can override config values
+	config     *config.Config
+	configMu   sync.RWMutex // guards config and lolClient, which is rebuilt from it
+	watcher    *config.Watcher
+	lolClient  *lol.Client
+	events     *lcu.EventBus
+	supervisor *lcu.Supervisor
+	runes      *presets.RuneStore
+	spells     *presets.SpellStore
+	itemSets   *presets.ItemSetStore
+	history    *history.DB
+	responses  *cache.Cache // Riot API response cache, shared by every client
+	liveGame   *liveclient.Poller
+	scout      *scouting.Scout
+
+	autoAccept  *lcu.AutoAcceptService  // nil while auto-accept is off
+	champSelect *lcu.ChampSelectService // nil while auto-pick and auto-ban are off
+	servicesMu  sync.Mutex              // guards autoAccept and champSelect, which the supervisor reads on reconnect
+
+	appliedLoadout string       // game and champion the presets were last applied for
+	loadoutMu      sync.Mutex   // serializes applying presets
+	loadoutSeq     atomic.Int64 // incremented for every loadout started; only the latest is applied
+	gameEnded      atomic.Bool  // the post-game rank snapshot was taken for the current game
+	scoutedLobby   string       // teammates and champions the last scouting report was started for
+
+	scoutSeq     atomic.Int64 // incremented for every report started; only the latest is emitted
+	scoutReports []*scouting.Report
+	scoutMu      sync.Mutex
 }
 
-// New creates a new App instance.
-func New() *App {
-	return &App{}
+// New creates a new App instance for the given command line arguments.
+func New(args []string) *App {
+	return &App{
+		args:   args,
+		events: lcu.NewEventBus(),
+	}
 }
 
 // Startup initializes the app when Wails starts.
@@ -28,7 +71,17 @@ func (a *App) Startup(ctx context.Context) {
 	a.ctx = ctx
 	a.setupLogging()
 	a.setupLCUCallbacks()
+	a.setupEventForwarding()
+	a.setupLoadoutImport()
+	a.setupRankTracker()
+	a.setupLiveGame()
+	a.setupScouting()
 	a.loadConfig()
+	a.watchConfig()
+	a.loadPresets()
+	a.openHistory()
+	a.openResponseCache()
+	a.startSupervisor(a.initLCUDiscovery())
 	a.initLolClient()
 }
 
@@ -50,58 +103,207 @@ func (a *App) setupLCUCallbacks() {
 
 // Shutdown cleans up resources when the app closes.
 func (a *App) Shutdown(_ context.Context) {
-	// Cleanup if needed
+	a.stopExistingService()
+	a.stopChampSelectService()
+	if a.watcher != nil {
+		a.watcher.Stop()
+	}
+	if a.supervisor != nil {
+		a.supervisor.Stop()
+	}
+	if a.liveGame != nil {
+		a.liveGame.Stop()
+	}
+	if a.history != nil {
+		a.history.Close()
+	}
+	if a.responses != nil {
+		a.responses.Close()
+	}
 }
 
 // loadConfig loads the configuration.
 func (a *App) loadConfig() {
-	cfg, err := config.Load()
+	a.config = config.Load(a.args)
+}
+
+// watchConfig reloads the configuration when the config file is changed outside the app.
+func (a *App) watchConfig() {
+	a.watcher = config.NewWatcher(config.DefaultWatchInterval, a.reloadConfig)
+	a.watcher.Start()
+}
+
+// reloadConfig swaps in the configuration from the changed config file. The LoL API client,
+// and with it its rate limiter state, is only rebuilt if the active key or region changed.
+// LCU discovery and the disk cache keep their settings until restart.
+// A file that is not valid JSON leaves the current configuration in effect.
+func (a *App) reloadConfig() {
+	a.configMu.Lock()
+	cfg, replaced := config.Reload(a.config, a.args)
+	profileChanged := replaced && (cfg.RiotAPIKey != a.config.RiotAPIKey || cfg.Region != a.config.Region)
+	a.config = cfg
+	if profileChanged {
+		a.updateLolClient()
+	}
+	active := a.config.ActiveProfile
+	a.configMu.Unlock()
+
+	if profileChanged {
+		runtime.EventsEmit(a.ctx, "profile-changed", active)
+	}
+	runtime.EventsEmit(a.ctx, "config-changed", "file")
+}
+
+// updateConfig applies a change to the configuration under the config lock and saves it.
+// If change reports that the active profile's key or region changed, the LoL API client
+// is rebuilt and the frontend is told.
+func (a *App) updateConfig(change func(cfg *config.Config) (profileChanged bool, err error)) error {
+	a.configMu.Lock()
+	profileChanged, err := change(a.config)
 	if err != nil {
-		cfg = config.Default()
+		a.configMu.Unlock()
+		return err
 	}
-	a.config = cfg
+	if profileChanged {
+		a.updateLolClient()
+	}
+	err = config.Save(a.config)
+	active := a.config.ActiveProfile
+	a.configMu.Unlock()
+
+	if profileChanged {
+		runtime.EventsEmit(a.ctx, "profile-changed", active)
+	}
+	runtime.EventsEmit(a.ctx, "config-changed", "app")
+	return err
 }
 
-// initLolClient initializes the LoL API client.
-func (a *App) initLolClient() {
-	if a.config.RiotAPIKey == "" {
+// loadPresets loads the rune, summoner spell and item set presets from the config directory.
+// A store that fails to load stays nil and its App methods report it as unavailable.
+func (a *App) loadPresets() {
+	dir, err := config.Dir()
+	if err != nil {
 		return
 	}
 
-	client, err := lol.NewClient(a.config.RiotAPIKey, a.config.Region)
+	if runes, err := presets.LoadRuneStore(dir); err == nil {
+		a.runes = runes
+	}
+	if spells, err := presets.LoadSpellStore(dir); err == nil {
+		a.spells = spells
+	}
+	if itemSets, err := presets.LoadItemSetStore(dir); err == nil {
+		a.itemSets = itemSets
+	}
+}
+
+// openHistory opens the local match database in the config directory.
+// If it fails to open, history features report it as unavailable.
+func (a *App) openHistory() {
+	dir, err := config.Dir()
 	if err != nil {
 		return
 	}
-	a.lolClient = client
+
+	if db, err := history.Open(dir); err == nil {
+		a.history = db
+	}
+}
+
+// openResponseCache creates the Riot API response cache, backed by the config
+// directory if the disk cache is enabled. It falls back to memory only.
+func (a *App) openResponseCache() {
+	a.configMu.RLock()
+	diskCache := a.config.DiskCache
+	a.configMu.RUnlock()
+
+	var store cache.Store
+	if diskCache {
+		if dir, err := config.Dir(); err == nil {
+			if disk, err := cache.OpenDisk(dir); err == nil {
+				store = disk
+			}
+		}
+	}
+	a.responses = cache.New(responseCacheSize, store)
+}
+
+// initLolClient initializes the LoL API client.
+func (a *App) initLolClient() {
+	a.configMu.Lock()
+	defer a.configMu.Unlock()
+	a.updateLolClient()
 }
 
-// GetConfig returns the current configuration.
+// newLolClient creates a LoL API client for the current config using the shared response cache.
+// The caller holds the config lock.
+func (a *App) newLolClient() (*lol.Client, error) {
+	client, err := lol.NewClient(a.config.RiotAPIKey, a.config.Region)
+	if err != nil {
+		return nil, err
+	}
+	if a.responses != nil {
+		client.SetCache(a.responses)
+	}
+	return client, nil
+}
+
+// GetConfig returns a copy of the current configuration.
 func (a *App) GetConfig() *config.Config {
-	return a.config
+	a.configMu.RLock()
+	defer a.configMu.RUnlock()
+	return a.config.Clone()
 }
 
 // SetAPIKey updates the Riot API key and reinitializes the client.
 func (a *App) SetAPIKey(apiKey string) error {
-	a.config.RiotAPIKey = apiKey
-	a.updateLolClient()
-	return config.Save(a.config)
+	return a.updateConfig(func(cfg *config.Config) (bool, error) {
+		cfg.SetAPIKey(apiKey)
+		return true, nil
+	})
 }
 
 // SetRegion updates the region and reinitializes the client.
+// Unknown regions are rejected and leave the config unchanged.
 func (a *App) SetRegion(region string) error {
-	a.config.Region = region
-	a.updateLolClient()
-	return config.Save(a.config)
+	platform, err := lol.LookupPlatform(region)
+	if err != nil {
+		return err
+	}
+
+	return a.updateConfig(func(cfg *config.Config) (bool, error) {
+		cfg.SetRegion(platform.ID)
+		return true, nil
+	})
+}
+
+// GetConfigIssues returns the problems with the config, e.g. an unknown region or a malformed API key.
+func (a *App) GetConfigIssues() []config.Issue {
+	a.configMu.RLock()
+	defer a.configMu.RUnlock()
+	return a.config.Issues()
+}
+
+// GetConfigDoctor returns every effective config value and where it came from.
+func (a *App) GetConfigDoctor() *config.DoctorReport {
+	a.configMu.RLock()
+	defer a.configMu.RUnlock()
+	return a.config.Doctor()
+}
+
+// GetRegions returns the supported regions and the clusters their regional endpoints use.
+func (a *App) GetRegions() []lol.Platform {
+	return lol.Platforms()
 }
 
-// updateLolClient updates the LoL client based on current config.
+// updateLolClient updates the LoL client based on current config. The caller holds the config lock.
 func (a *App) updateLolClient() {
 	if a.config.RiotAPIKey == "" {
 		a.lolClient = nil
 		return
 	}
 
-	client, err := lol.NewClient(a.config.RiotAPIKey, a.config.Region)
+	client, err := a.newLolClient()
 	if err != nil {
 		a.lolClient = nil
 		return
@@ -110,7 +312,25 @@ func (a *App) updateLolClient() {
 	a.lolClient = client
 }
 
+// riotClient returns the LoL API client, or nil until an API key is set. Callers read it
+// once and keep using that client, as a config change can replace it in the meantime.
+func (a *App) riotClient() *lol.Client {
+	a.configMu.RLock()
+	defer a.configMu.RUnlock()
+	return a.lolClient
+}
+
 // IsConfigured returns true if the API key is set.
 func (a *App) IsConfigured() bool {
+	a.configMu.RLock()
+	defer a.configMu.RUnlock()
 	return a.config != nil && a.config.RiotAPIKey != ""
 }
+
+// ClearResponseCache drops every cached Riot API response.
+func (a *App) ClearResponseCache() error {
+	if a.responses == nil {
+		return nil
+	}
+	return a.responses.Clear()
+}
@@ -8,11 +8,6 @@ import (
 	"lol-toolkit/internal/lcu"
 )
 
-var (
-	autoAcceptService *lcu.AutoAcceptService
-	appInstance       *App
-)
-
 // AutoAcceptConfig represents the auto-accept configuration.
 type AutoAcceptConfig struct {
 	Enabled    bool `json:"enabled"`
@@ -21,21 +16,20 @@ type AutoAcceptConfig struct {
 
 // StartAutoAccept starts the auto-accept service.
 func (a *App) StartAutoAccept(config AutoAcceptConfig) error {
-	appInstance = a
+	a.servicesMu.Lock()
+	defer a.servicesMu.Unlock()
 
-	if err := a.stopExistingService(); err != nil {
-		return err
-	}
+	a.stopAutoAcceptLocked()
 
-	client, err := lcu.NewClient()
+	client, err := a.lcuClient()
 	if err != nil {
 		return fmt.Errorf("failed to create LCU client: %w", err)
 	}
 
-	autoAcceptService = lcu.NewAutoAcceptService(client)
-	autoAcceptService.SetAutoAccept(config.AutoAccept)
-	autoAcceptService.SetOnStopped(a.createStoppedCallback())
-	autoAcceptService.Start()
+	a.autoAccept = lcu.NewAutoAcceptService(client, a.events)
+	a.autoAccept.SetAutoAccept(config.AutoAccept)
+	a.autoAccept.SetOnStopped(a.createStoppedCallback())
+	a.autoAccept.Start()
 
 	return nil
 }
@@ -47,34 +41,55 @@ func (a *App) StopAutoAccept() {
 
 // UpdateAutoAcceptConfig updates the auto-accept configuration.
 func (a *App) UpdateAutoAcceptConfig(config AutoAcceptConfig) error {
-	if autoAcceptService == nil {
+	a.servicesMu.Lock()
+	defer a.servicesMu.Unlock()
+
+	if a.autoAccept == nil {
 		return fmt.Errorf("auto-accept service not started")
 	}
 
-	autoAcceptService.SetAutoAccept(config.AutoAccept)
+	a.autoAccept.SetAutoAccept(config.AutoAccept)
 	return nil
 }
 
 // IsAutoAcceptRunning returns true if the auto-accept service is currently running.
 func (a *App) IsAutoAcceptRunning() bool {
-	return autoAcceptService != nil
+	a.servicesMu.Lock()
+	defer a.servicesMu.Unlock()
+	return a.autoAccept != nil
+}
+
+// resumeAutoAccept hands a reconnected client to the running auto-accept service.
+func (a *App) resumeAutoAccept(client *lcu.Client) {
+	a.servicesMu.Lock()
+	defer a.servicesMu.Unlock()
+
+	if a.autoAccept != nil {
+		a.autoAccept.SetClient(client)
+	}
 }
 
 // stopExistingService stops the existing auto-accept service if running.
-func (a *App) stopExistingService() error {
-	if autoAcceptService != nil {
-		autoAcceptService.Stop()
-		autoAcceptService = nil
+func (a *App) stopExistingService() {
+	a.servicesMu.Lock()
+	defer a.servicesMu.Unlock()
+	a.stopAutoAcceptLocked()
+}
+
+// stopAutoAcceptLocked stops the auto-accept service if running. The caller holds servicesMu.
+func (a *App) stopAutoAcceptLocked() {
+	if a.autoAccept != nil {
+		a.autoAccept.Stop()
+		a.autoAccept = nil
 	}
-	return nil
 }
 
-// createStoppedCallback creates a callback to notify frontend when service stops.
+// createStoppedCallback creates a callback to notify frontend when auto-accept turns itself off.
 func (a *App) createStoppedCallback() func() {
 	return func() {
-		if appInstance != nil && appInstance.ctx != nil {
-			runtime.EventsEmit(appInstance.ctx, "auto-accept-stopped", map[string]interface{}{
-				"reason": "connection_error",
+		if a.ctx != nil {
+			runtime.EventsEmit(a.ctx, "auto-accept-stopped", map[string]interface{}{
+				"reason": "game_started",
 			})
 		}
 	}
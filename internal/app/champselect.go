@@ -0,0 +1,99 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"lol-toolkit/internal/lcu"
+)
+
+// ChampSelectConfig represents the champ select auto-pick and auto-ban configuration.
+// Priority lists map an assigned position ("top", "jungle", "middle", "bottom",
+// "utility", or "" for any) to champion IDs in priority order.
+type ChampSelectConfig struct {
+	AutoPick      bool             `json:"autoPick"`
+	AutoBan       bool             `json:"autoBan"`
+	PickPriority  map[string][]int `json:"pickPriority"`
+	BanPriority   map[string][]int `json:"banPriority"`
+	LockInDelayMs int              `json:"lockInDelayMs"`
+}
+
+// StartChampSelect starts the champ select service.
+func (a *App) StartChampSelect(config ChampSelectConfig) error {
+	a.servicesMu.Lock()
+	defer a.servicesMu.Unlock()
+
+	a.stopChampSelectLocked()
+
+	client, err := a.lcuClient()
+	if err != nil {
+		return fmt.Errorf("failed to create LCU client: %w", err)
+	}
+
+	a.champSelect = lcu.NewChampSelectService(client, a.events)
+	a.champSelect.SetSettings(config.toSettings())
+	a.champSelect.Start()
+
+	return nil
+}
+
+// StopChampSelect stops the champ select service.
+func (a *App) StopChampSelect() {
+	a.stopChampSelectService()
+}
+
+// UpdateChampSelectConfig updates the champ select configuration.
+func (a *App) UpdateChampSelectConfig(config ChampSelectConfig) error {
+	a.servicesMu.Lock()
+	defer a.servicesMu.Unlock()
+
+	if a.champSelect == nil {
+		return fmt.Errorf("champ select service not started")
+	}
+
+	a.champSelect.SetSettings(config.toSettings())
+	return nil
+}
+
+// IsChampSelectRunning returns true if the champ select service is currently running.
+func (a *App) IsChampSelectRunning() bool {
+	a.servicesMu.Lock()
+	defer a.servicesMu.Unlock()
+	return a.champSelect != nil
+}
+
+// resumeChampSelect hands a reconnected client to the running champ select service.
+func (a *App) resumeChampSelect(client *lcu.Client) {
+	a.servicesMu.Lock()
+	defer a.servicesMu.Unlock()
+
+	if a.champSelect != nil {
+		a.champSelect.SetClient(client)
+	}
+}
+
+// stopChampSelectService stops the champ select service if running.
+func (a *App) stopChampSelectService() {
+	a.servicesMu.Lock()
+	defer a.servicesMu.Unlock()
+	a.stopChampSelectLocked()
+}
+
+// stopChampSelectLocked stops the champ select service if running. The caller holds servicesMu.
+func (a *App) stopChampSelectLocked() {
+	if a.champSelect != nil {
+		a.champSelect.Stop()
+		a.champSelect = nil
+	}
+}
+
+// toSettings converts the frontend config to service settings.
+func (c ChampSelectConfig) toSettings() lcu.ChampSelectSettings {
+	return lcu.ChampSelectSettings{
+		AutoPick:     c.AutoPick,
+		AutoBan:      c.AutoBan,
+		PickPriority: c.PickPriority,
+		BanPriority:  c.BanPriority,
+		LockInDelay:  time.Duration(c.LockInDelayMs) * time.Millisecond,
+	}
+}
@@ -0,0 +1,23 @@
+package app
+
+import (
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+
+	"lol-toolkit/internal/lcu"
+)
+
+// frontendTopics maps LCU URI patterns to the frontend events they are re-emitted as.
+var frontendTopics = map[string]string{
+	"/lol-gameflow/v1/gameflow-phase": "lcu:gameflow",
+	"/lol-champ-select/v1/session":    "lcu:champ-select",
+	"/lol-lobby/v2/lobby":             "lcu:lobby",
+}
+
+// setupEventForwarding re-emits selected LCU events to the frontend.
+func (a *App) setupEventForwarding() {
+	for pattern, topic := range frontendTopics {
+		a.events.Subscribe(pattern, func(event *lcu.Event) {
+			runtime.EventsEmit(a.ctx, topic, event)
+		})
+	}
+}
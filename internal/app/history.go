@@ -0,0 +1,83 @@
+package app
+
+import (
+	"fmt"
+
+	"lol-toolkit/internal/history"
+	"lol-toolkit/internal/lol"
+)
+
+// TrackAccount starts syncing the match history of a Riot ID (gameName#tagLine)
+func (a *App) TrackAccount(riotID string) (*history.TrackedAccount, error) {
+	if a.history == nil {
+		return nil, fmt.Errorf("match database unavailable")
+	}
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
+	}
+
+	summoner, err := client.SearchByRiotID(riotID)
+	if err != nil {
+		return nil, err
+	}
+
+	account := history.TrackedAccount{
+		PUUID:    summoner.PUUID,
+		GameName: summoner.GameName,
+		TagLine:  summoner.TagLine,
+	}
+	if err := a.history.TrackAccount(account); err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// UntrackAccount stops syncing an account; its stored matches are kept
+func (a *App) UntrackAccount(puuid string) error {
+	if a.history == nil {
+		return fmt.Errorf("match database unavailable")
+	}
+
+	return a.history.UntrackAccount(puuid)
+}
+
+// ListTrackedAccounts returns the accounts whose match history is synced
+func (a *App) ListTrackedAccounts() ([]history.TrackedAccount, error) {
+	if a.history == nil {
+		return nil, fmt.Errorf("match database unavailable")
+	}
+
+	return a.history.TrackedAccounts()
+}
+
+// SyncMatchHistory fetches new matches and a ranked snapshot for every tracked account
+func (a *App) SyncMatchHistory() ([]history.SyncResult, error) {
+	if a.history == nil {
+		return nil, fmt.Errorf("match database unavailable")
+	}
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
+	}
+
+	return a.history.SyncAll(client)
+}
+
+// GetStoredMatches returns a page of a player's stored matches, most recent first
+func (a *App) GetStoredMatches(puuid string, offset, limit int) ([]*lol.MatchInfo, error) {
+	if a.history == nil {
+		return nil, fmt.Errorf("match database unavailable")
+	}
+
+	return a.history.Matches(puuid, offset, limit)
+}
+
+// GetAccountStats computes a player's stats from stored matches (queueID 0 for all queues)
+func (a *App) GetAccountStats(puuid string, queueID int) (*history.AccountStats, error) {
+	if a.history == nil {
+		return nil, fmt.Errorf("match database unavailable")
+	}
+
+	return a.history.Stats(puuid, queueID)
+}
@@ -0,0 +1,34 @@
+package app
+
+import (
+	"fmt"
+
+	"lol-toolkit/internal/presets"
+)
+
+// ListItemSetPresets returns all saved item set presets.
+func (a *App) ListItemSetPresets() ([]presets.ItemSetPreset, error) {
+	if a.itemSets == nil {
+		return nil, fmt.Errorf("item set presets unavailable")
+	}
+
+	return a.itemSets.List(), nil
+}
+
+// SaveItemSetPreset adds or replaces an item set preset for a champion, role and queue.
+func (a *App) SaveItemSetPreset(preset presets.ItemSetPreset) error {
+	if a.itemSets == nil {
+		return fmt.Errorf("item set presets unavailable")
+	}
+
+	return a.itemSets.Save(preset)
+}
+
+// DeleteItemSetPreset removes the item set preset for a champion, role and queue.
+func (a *App) DeleteItemSetPreset(championID int, position string, queueID int) error {
+	if a.itemSets == nil {
+		return fmt.Errorf("item set presets unavailable")
+	}
+
+	return a.itemSets.Delete(championID, position, queueID)
+}
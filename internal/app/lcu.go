@@ -3,23 +3,72 @@ package app
 import (
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/wailsapp/wails/v2/pkg/runtime"
 
+	"lol-toolkit/internal/config"
 	"lol-toolkit/internal/lcu"
 )
 
-// LCUStatus represents the League client connection status.
+// LCUStatus represents the League client connection status. The auth token is left out,
+// as credentials never reach the UI.
 type LCUStatus struct {
 	Connected bool   `json:"connected"`
+	State     string `json:"state"`
 	Port      string `json:"port,omitempty"`
-	AuthToken string `json:"authToken,omitempty"`
 	Error     string `json:"error,omitempty"`
 }
 
+// initLCUDiscovery configures how the League client is found, using the config's priority order.
+// It returns the uncached discoverer for the supervisor.
+func (a *App) initLCUDiscovery() lcu.ConnectionDiscoverer {
+	a.configMu.RLock()
+	cfg := a.config.LCU
+	a.configMu.RUnlock()
+	if len(cfg.Discovery) == 0 {
+		cfg.Discovery = config.DefaultLCU().Discovery
+	}
+
+	discoverer, err := lcu.NewDiscoverer(cfg.Discovery, cfg.LeaguePath, lcu.StaticDiscoverer{
+		Host:      cfg.Host,
+		Port:      cfg.Port,
+		AuthToken: cfg.AuthToken,
+	})
+	if err != nil {
+		discoverer, _ = lcu.NewDiscoverer(config.DefaultLCU().Discovery, "", lcu.StaticDiscoverer{})
+	}
+	lcu.SetDefaultDiscoverer(discoverer)
+	return discoverer
+}
+
+// startSupervisor keeps the LCU connection alive and resumes dependent services on reconnect.
+func (a *App) startSupervisor(discoverer lcu.ConnectionDiscoverer) {
+	a.supervisor = lcu.NewSupervisor(discoverer, a.events)
+	a.supervisor.SetOnStateChange(func(state lcu.SupervisorState) {
+		runtime.EventsEmit(a.ctx, "lcu-state-changed", map[string]interface{}{
+			"state": state,
+		})
+	})
+	a.supervisor.OnConnect(a.resumeAutoAccept)
+	a.supervisor.OnConnect(a.resumeChampSelect)
+	a.supervisor.OnConnect(a.resumeLiveGame)
+	a.supervisor.Start()
+}
+
+// lcuClient returns the supervised client, or creates one if the supervisor is not connected yet.
+func (a *App) lcuClient() (*lcu.Client, error) {
+	if a.supervisor != nil {
+		if client := a.supervisor.Client(); client != nil {
+			return client, nil
+		}
+	}
+	return lcu.NewClient(lcu.DefaultDiscoverer())
+}
+
 // GetLCUStatus checks if the League client is running.
 func (a *App) GetLCUStatus() *LCUStatus {
 	start := time.Now()
@@ -38,7 +87,7 @@ func (a *App) GetLCUStatus() *LCUStatus {
 func (a *App) GetCurrentSummoner() (*lcu.CurrentSummoner, error) {
 	start := time.Now()
 
-	client, err := lcu.NewClient()
+	client, err := a.lcuClient()
 	if err != nil {
 		a.emitSummonerError(err, time.Since(start))
 		return nil, err
@@ -49,58 +98,44 @@ func (a *App) GetCurrentSummoner() (*lcu.CurrentSummoner, error) {
 
 // createStatus creates an LCUStatus from connection info.
 func (a *App) createStatus(info *lcu.ConnectionInfo) *LCUStatus {
+	state := lcu.SupervisorStateSearching
+	if a.supervisor != nil {
+		state = a.supervisor.State()
+	}
+
 	if info == nil {
 		return &LCUStatus{
 			Connected: false,
+			State:     string(state),
 			Error:     "League client not running",
 		}
 	}
 
 	return &LCUStatus{
 		Connected: true,
+		State:     string(state),
 		Port:      info.Port,
-		AuthToken: info.AuthToken,
 	}
 }
 
-// emitStatusLog emits a status check log event to the frontend.
+// emitStatusLog logs a status check through the API logger, which redacts the auth header.
 func (a *App) emitStatusLog(status *LCUStatus, duration time.Duration, info *lcu.ConnectionInfo) {
 	statusJSON, _ := json.MarshalIndent(status, "", "  ")
 	headers := buildLCUHeaders(info)
 
-	logEntry := map[string]interface{}{
-		"type":     "lcu",
-		"method":   "GET",
-		"endpoint": "GetLCUStatus",
-		"duration": duration.Milliseconds(),
-		"headers":  headers,
-		"response": string(statusJSON),
-	}
-
 	if status.Connected {
-		logEntry["statusCode"] = http.StatusOK
-	} else {
-		logEntry["statusCode"] = http.StatusInternalServerError
-		logEntry["error"] = status.Error
+		lcu.LogRequest("GET", "GetLCUStatus", http.StatusOK, duration, headers, string(statusJSON), nil)
+		return
 	}
-
-	runtime.EventsEmit(a.ctx, "api-call", logEntry)
+	lcu.LogRequest("GET", "GetLCUStatus", http.StatusInternalServerError, duration, headers, string(statusJSON), errors.New(status.Error))
 }
 
-// emitSummonerError emits an error log event for summoner fetch failures.
+// emitSummonerError logs a summoner fetch failure through the API logger, which redacts the auth header.
 func (a *App) emitSummonerError(err error, duration time.Duration) {
 	info := lcu.GetConnectionInfo()
 	headers := buildLCUHeaders(info)
 
-	runtime.EventsEmit(a.ctx, "api-call", map[string]interface{}{
-		"type":       "lcu",
-		"method":     "GET",
-		"endpoint":   "/lol-summoner/v1/current-summoner",
-		"statusCode": http.StatusInternalServerError,
-		"duration":   duration.Milliseconds(),
-		"headers":    headers,
-		"error":      err.Error(),
-	})
+	lcu.LogError("GET", "/lol-summoner/v1/current-summoner", duration, headers, err, http.StatusInternalServerError)
 }
 
 // buildLCUHeaders creates HTTP headers for LCU API requests.
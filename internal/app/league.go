@@ -1,44 +1,75 @@
 package app
 
 import (
-	"fmt"
-
 	"lol-toolkit/internal/lol"
 )
 
 // GetRankedStats gets ranked stats for a summoner
 func (a *App) GetRankedStats(summonerID string) ([]*lol.RankedInfo, error) {
-	if a.lolClient == nil {
-		return nil, fmt.Errorf("API client not initialized. Please set your API key first")
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
 	}
 
-	return a.lolClient.GetRankedStats(summonerID)
+	return client.GetRankedStats(summonerID)
 }
 
 // GetChallengers gets the challenger leaderboard
 func (a *App) GetChallengers(queueType string) (*lol.LeagueListInfo, error) {
-	if a.lolClient == nil {
-		return nil, fmt.Errorf("API client not initialized. Please set your API key first")
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
 	}
 
-	return a.lolClient.GetChallengers(queueType)
+	return client.GetChallengers(queueType)
 }
 
 // GetGrandmasters gets the grandmaster leaderboard
 func (a *App) GetGrandmasters(queueType string) (*lol.LeagueListInfo, error) {
-	if a.lolClient == nil {
-		return nil, fmt.Errorf("API client not initialized. Please set your API key first")
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
 	}
 
-	return a.lolClient.GetGrandmasters(queueType)
+	return client.GetGrandmasters(queueType)
 }
 
 // GetMasters gets the master leaderboard
 func (a *App) GetMasters(queueType string) (*lol.LeagueListInfo, error) {
-	if a.lolClient == nil {
-		return nil, fmt.Errorf("API client not initialized. Please set your API key first")
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
+	}
+
+	return client.GetMasters(queueType)
+}
+
+// GetLeagueEntries gets a page (starting at 1) of the players in a tier and division below Master
+func (a *App) GetLeagueEntries(queueType, tier, division string, page int) ([]*lol.RankedInfo, error) {
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
+	}
+
+	return client.GetLeagueEntries(queueType, tier, division, page)
+}
+
+// GetLadder gets the best players of a tier, sorted by LP with Riot IDs resolved
+func (a *App) GetLadder(queueType, tier string) (*lol.Ladder, error) {
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
 	}
 
-	return a.lolClient.GetMasters(queueType)
+	return client.GetLadder(queueType, tier)
 }
 
+// GetRateLimitState returns the Riot API rate limit usage for the Debug tab
+func (a *App) GetRateLimitState() []lol.RateLimitState {
+	client := a.riotClient()
+	if client == nil {
+		return []lol.RateLimitState{}
+	}
+
+	return client.GetRateLimits()
+}
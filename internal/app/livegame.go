@@ -0,0 +1,51 @@
+package app
+
+import (
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+
+	"lol-toolkit/internal/lcu"
+	"lol-toolkit/internal/liveclient"
+)
+
+// setupLiveGame polls the Live Client Data API while a game is in progress
+// and streams scoreboard snapshots to the frontend.
+func (a *App) setupLiveGame() {
+	a.liveGame = liveclient.NewPoller(liveclient.NewClient(), liveclient.DefaultPollInterval, func(snapshot *liveclient.Snapshot) {
+		runtime.EventsEmit(a.ctx, "live-game-update", snapshot)
+	})
+	lcu.SubscribeJSON(a.events, "/lol-gameflow/v1/gameflow-phase", a.handleLiveGamePhase, lcu.EventTypeUpdate)
+}
+
+// handleLiveGamePhase starts polling when the game starts and stops when it ends.
+func (a *App) handleLiveGamePhase(_ *lcu.Event, phase lcu.GameflowPhase) {
+	a.updateLiveGame(phase)
+}
+
+// resumeLiveGame starts polling if the app connects while a game is already running.
+func (a *App) resumeLiveGame(client *lcu.Client) {
+	if phase, err := client.GetGameflowPhase(); err == nil {
+		a.updateLiveGame(phase)
+	}
+}
+
+// updateLiveGame starts or stops the poller for a gameflow phase. Polling continues
+// while reconnecting, since the game is still running.
+func (a *App) updateLiveGame(phase lcu.GameflowPhase) {
+	if phase == lcu.GameflowPhaseInProgress || phase == lcu.GameflowPhaseReconnect {
+		a.liveGame.Start()
+		return
+	}
+
+	if a.liveGame.Running() {
+		a.liveGame.Stop()
+		runtime.EventsEmit(a.ctx, "live-game-ended")
+	}
+}
+
+// GetLiveGame returns the latest snapshot of the game in progress, or nil if there is none.
+func (a *App) GetLiveGame() *liveclient.Snapshot {
+	if a.liveGame == nil {
+		return nil
+	}
+	return a.liveGame.Latest()
+}
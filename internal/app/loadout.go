@@ -0,0 +1,169 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+
+	"lol-toolkit/internal/config"
+	"lol-toolkit/internal/lcu"
+	"lol-toolkit/internal/presets"
+)
+
+// flashSpellID is the summoner spell ID of Flash.
+const flashSpellID = 4
+
+// setupLoadoutImport applies the matching presets when the local player locks in a champion.
+func (a *App) setupLoadoutImport() {
+	lcu.SubscribeJSON(a.events, "/lol-champ-select/v1/session", a.handleLockIn)
+}
+
+// handleLockIn applies rune, summoner spell and item set presets once per game and champion
+// after lock-in, or in ARAM once the champion is assigned.
+// The presets are applied on their own goroutine, as each is a round trip to the client.
+func (a *App) handleLockIn(event *lcu.Event, session lcu.ChampSelectSession) {
+	if event.EventType == lcu.EventTypeDelete {
+		a.appliedLoadout = ""
+		return
+	}
+
+	championID := session.LockedInChampion()
+	if championID == 0 {
+		return
+	}
+
+	key := fmt.Sprintf("%d:%d", session.GameID, championID)
+	if key == a.appliedLoadout {
+		return
+	}
+	a.appliedLoadout = key
+
+	position := ""
+	if player := session.LocalPlayer(); player != nil {
+		position = player.AssignedPosition
+	}
+
+	go a.applyLoadout(a.loadoutSeq.Add(1), championID, position)
+}
+
+// applyLoadout applies the presets for a locked in champion. Loadouts are applied one at
+// a time, and one that was superseded while it waited is skipped, so the presets of a
+// later champion are never overwritten by an earlier one.
+func (a *App) applyLoadout(seq int64, championID int, position string) {
+	a.loadoutMu.Lock()
+	defer a.loadoutMu.Unlock()
+
+	if seq != a.loadoutSeq.Load() {
+		return
+	}
+
+	client, err := a.lcuClient()
+	if err != nil {
+		return
+	}
+
+	queueID, _ := client.GetGameflowQueueID()
+
+	a.applyRunes(client, championID, position)
+	a.applySpells(client, championID, position, queueID)
+	a.applyItemSet(client, championID, position, queueID)
+}
+
+// applyRunes imports the rune preset for the champion and role.
+func (a *App) applyRunes(client *lcu.Client, championID int, position string) {
+	if a.runes == nil {
+		return
+	}
+
+	preset, ok := a.runes.Find(championID, position)
+	if !ok {
+		return
+	}
+
+	_, err := client.ApplyManagedRunePage(lcu.RunePage{
+		Name:            preset.Name,
+		PrimaryStyleID:  preset.PrimaryStyleID,
+		SubStyleID:      preset.SubStyleID,
+		SelectedPerkIDs: preset.SelectedPerkIDs,
+	})
+	a.emitLoadoutResult("runes-applied", championID, position, preset.Name, err)
+}
+
+// applySpells sets the summoner spell preset for the champion, role and queue.
+func (a *App) applySpells(client *lcu.Client, championID int, position string, queueID int) {
+	if a.spells == nil {
+		return
+	}
+
+	preset, ok := a.spells.Find(championID, position, queueID)
+	if !ok {
+		return
+	}
+
+	a.configMu.RLock()
+	order := a.config.SpellSlotOrder
+	a.configMu.RUnlock()
+
+	spell1, spell2 := orderSpells(preset.Spell1ID, preset.Spell2ID, order)
+	err := client.SetSummonerSpells(spell1, spell2)
+	a.emitLoadoutResult("spells-applied", championID, position, fmt.Sprintf("%d/%d", spell1, spell2), err)
+}
+
+// applyItemSet writes the item set preset for the champion, role and queue.
+func (a *App) applyItemSet(client *lcu.Client, championID int, position string, queueID int) {
+	if a.itemSets == nil {
+		return
+	}
+
+	preset, ok := a.itemSets.Find(championID, position, queueID)
+	if !ok {
+		return
+	}
+
+	summoner, err := client.GetCurrentSummoner()
+	if err == nil {
+		err = client.ApplyManagedItemSet(summoner.SummonerID, championID, toItemSet(preset))
+	}
+	a.emitLoadoutResult("item-set-applied", championID, position, preset.Title, err)
+}
+
+// emitLoadoutResult notifies the frontend that a preset was applied or failed.
+func (a *App) emitLoadoutResult(eventName string, championID int, position, name string, err error) {
+	result := map[string]interface{}{
+		"championId": championID,
+		"position":   position,
+		"name":       name,
+	}
+	if err != nil {
+		result["error"] = err.Error()
+	}
+	runtime.EventsEmit(a.ctx, eventName, result)
+}
+
+// orderSpells applies the configured D/F slot order to a spell pair.
+func orderSpells(spell1, spell2 int, order string) (int, int) {
+	switch {
+	case order == config.SpellSlotOrderFlashD && spell2 == flashSpellID,
+		order == config.SpellSlotOrderFlashF && spell1 == flashSpellID:
+		return spell2, spell1
+	default:
+		return spell1, spell2
+	}
+}
+
+// toItemSet converts an item set preset to the LCU format.
+func toItemSet(preset presets.ItemSetPreset) lcu.ItemSet {
+	blocks := make([]lcu.ItemSetBlock, len(preset.Blocks))
+	for i, block := range preset.Blocks {
+		items := make([]lcu.ItemSetItem, len(block.Items))
+		for j, item := range block.Items {
+			items[j] = lcu.ItemSetItem{ID: item.ID, Count: item.Count}
+		}
+		blocks[i] = lcu.ItemSetBlock{Type: block.Type, Items: items}
+	}
+
+	return lcu.ItemSet{
+		Title:  preset.Title,
+		Blocks: blocks,
+	}
+}
@@ -1,35 +1,35 @@
 package app
 
 import (
-	"fmt"
-
 	"lol-toolkit/internal/lol"
 )
 
 // GetChampionMastery gets mastery for a specific champion
 func (a *App) GetChampionMastery(summonerID string, championID string) (*lol.ChampionMasteryInfo, error) {
-	if a.lolClient == nil {
-		return nil, fmt.Errorf("API client not initialized. Please set your API key first")
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
 	}
 
-	return a.lolClient.GetChampionMastery(summonerID, championID)
+	return client.GetChampionMastery(summonerID, championID)
 }
 
 // GetAllChampionMasteries gets all champion masteries for a summoner
 func (a *App) GetAllChampionMasteries(summonerID string) ([]*lol.ChampionMasteryInfo, error) {
-	if a.lolClient == nil {
-		return nil, fmt.Errorf("API client not initialized. Please set your API key first")
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
 	}
 
-	return a.lolClient.GetAllChampionMasteries(summonerID)
+	return client.GetAllChampionMasteries(summonerID)
 }
 
 // GetTotalMasteryScore gets the total mastery score
 func (a *App) GetTotalMasteryScore(summonerID string) (int, error) {
-	if a.lolClient == nil {
-		return 0, fmt.Errorf("API client not initialized. Please set your API key first")
+	client := a.riotClient()
+	if client == nil {
+		return 0, errNotConfigured
 	}
 
-	return a.lolClient.GetTotalMasteryScore(summonerID)
+	return client.GetTotalMasteryScore(summonerID)
 }
-
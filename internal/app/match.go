@@ -0,0 +1,68 @@
+package app
+
+import (
+	"lol-toolkit/internal/lol"
+)
+
+// GetMatchIDs gets a page of match IDs for a player
+func (a *App) GetMatchIDs(puuid string, filters lol.MatchFilters) ([]string, error) {
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
+	}
+
+	return client.GetMatchIDs(puuid, filters)
+}
+
+// GetMatch gets the full detail of a match, from the local database if it is stored
+func (a *App) GetMatch(matchID string) (*lol.MatchInfo, error) {
+	if a.history != nil && a.history.HasMatch(matchID) {
+		return a.history.Match(matchID)
+	}
+
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
+	}
+
+	match, err := client.GetMatch(matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Finished matches never change, so keep them for offline browsing
+	if a.history != nil {
+		a.history.SaveMatch(match)
+	}
+	return match, nil
+}
+
+// GetMatchTimeline gets the minute-by-minute timeline of a match
+func (a *App) GetMatchTimeline(matchID string) (*lol.MatchTimeline, error) {
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
+	}
+
+	return client.GetMatchTimeline(matchID)
+}
+
+// GetMatchAnalysis gets gold/XP/CS curves, lane gold differences and objective participation for a match
+func (a *App) GetMatchAnalysis(matchID string) (*lol.MatchAnalysis, error) {
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
+	}
+
+	match, err := a.GetMatch(matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline, err := client.GetMatchTimeline(matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	return lol.AnalyzeMatch(match, timeline), nil
+}
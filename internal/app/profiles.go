@@ -0,0 +1,53 @@
+package app
+
+import (
+	"lol-toolkit/internal/config"
+	"lol-toolkit/internal/lol"
+)
+
+// GetProfiles lists the API key and region profiles
+func (a *App) GetProfiles() []config.ProfileInfo {
+	a.configMu.RLock()
+	defer a.configMu.RUnlock()
+	return a.config.ProfileInfos()
+}
+
+// CreateProfile adds a profile with its own API key and region. It does not switch to it.
+func (a *App) CreateProfile(name, apiKey, region string) error {
+	platform, err := lol.LookupPlatform(region)
+	if err != nil {
+		return err
+	}
+
+	return a.updateConfig(func(cfg *config.Config) (bool, error) {
+		return false, cfg.AddProfile(name, apiKey, platform.ID)
+	})
+}
+
+// SwitchProfile makes a profile active and rebuilds the LoL API client with its key and region
+func (a *App) SwitchProfile(name string) error {
+	return a.updateConfig(func(cfg *config.Config) (bool, error) {
+		if err := cfg.SwitchProfile(name); err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+}
+
+// DeleteProfile removes a profile. Deleting the active profile switches to the first remaining one.
+func (a *App) DeleteProfile(name string) error {
+	return a.updateConfig(func(cfg *config.Config) (bool, error) {
+		wasActive := cfg.ActiveProfile == name
+		if err := cfg.DeleteProfile(name); err != nil {
+			return false, err
+		}
+		return wasActive, nil
+	})
+}
+
+// GetSecretBackend reports where API keys are stored: "keyring" or "file" (encrypted)
+func (a *App) GetSecretBackend() string {
+	a.configMu.RLock()
+	defer a.configMu.RUnlock()
+	return a.config.SecretBackend()
+}
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+
+	"lol-toolkit/internal/history"
+	"lol-toolkit/internal/lcu"
+	"lol-toolkit/internal/lol"
+)
+
+// Ranked results can lag behind the end of the game, so the snapshot is retried until the game shows up.
+const (
+	rankSnapshotAttempts = 5
+	rankSnapshotRetry    = 20 * time.Second
+)
+
+// rankedQueueTypes maps ranked queue IDs to their league queue type.
+var rankedQueueTypes = map[int]string{
+	420: lol.QueueRankedSolo,
+	440: lol.QueueRankedFlex,
+}
+
+// setupRankTracker snapshots the current summoner's ranked entries after every game.
+func (a *App) setupRankTracker() {
+	lcu.SubscribeJSON(a.events, "/lol-gameflow/v1/gameflow-phase", a.handleGameflowPhase, lcu.EventTypeUpdate)
+}
+
+// handleGameflowPhase takes a snapshot once per game on the transition to the post-game phases.
+// The snapshot, and every call to the client it needs, runs on its own goroutine.
+func (a *App) handleGameflowPhase(_ *lcu.Event, phase lcu.GameflowPhase) {
+	gameOver := phase == lcu.GameflowPhaseWaitingForStats || phase == lcu.GameflowPhasePreEndOfGame
+	if !gameOver {
+		a.gameEnded.Store(false)
+		return
+	}
+	if a.gameEnded.Swap(true) {
+		return
+	}
+
+	go a.snapshotRankAfterGame()
+}
+
+// snapshotRankAfterGame stores a ranked snapshot of the current summoner. For ranked queues
+// it waits until the finished game is reflected in the queue's win/loss count.
+func (a *App) snapshotRankAfterGame() {
+	client := a.riotClient()
+	if a.history == nil || client == nil {
+		return
+	}
+
+	lcuClient, err := a.lcuClient()
+	if err != nil {
+		return
+	}
+	summoner, err := lcuClient.GetCurrentSummoner()
+	if err != nil {
+		return
+	}
+	puuid := summoner.PUUID
+	queueID, _ := lcuClient.GetGameflowQueueID()
+
+	queueType := rankedQueueTypes[queueID]
+	before := 0
+	if previous, err := a.history.LatestRankedSnapshot(puuid); err == nil && previous != nil {
+		before = previous.GamesPlayed(queueType)
+	}
+
+	for attempt := 1; ; attempt++ {
+		entries, err := history.FetchRanked(client, puuid)
+		if err == nil {
+			snapshot := history.RankedSnapshot{Entries: entries}
+			if queueType == "" || snapshot.GamesPlayed(queueType) != before || attempt == rankSnapshotAttempts {
+				if err := a.history.SaveRankedSnapshot(puuid, time.Now(), entries); err != nil {
+					return
+				}
+				runtime.EventsEmit(a.ctx, "rank-snapshot", map[string]interface{}{
+					"puuid":     puuid,
+					"queueType": queueType,
+				})
+				return
+			}
+		}
+
+		if attempt == rankSnapshotAttempts {
+			return
+		}
+		time.Sleep(rankSnapshotRetry)
+	}
+}
+
+// GetRankProgression returns the rank time series and per-game LP changes for a queue,
+// from snapshots taken in the last days (0 for all)
+func (a *App) GetRankProgression(puuid, queueType string, days int) (*history.RankProgression, error) {
+	if a.history == nil {
+		return nil, fmt.Errorf("match database unavailable")
+	}
+
+	since := time.Time{}
+	if days > 0 {
+		since = time.Now().AddDate(0, 0, -days)
+	}
+	return a.history.Progression(puuid, queueType, since)
+}
@@ -0,0 +1,64 @@
+package app
+
+import (
+	"fmt"
+
+	"lol-toolkit/internal/presets"
+)
+
+// ListRunePresets returns all saved rune presets.
+func (a *App) ListRunePresets() ([]presets.RunePreset, error) {
+	if a.runes == nil {
+		return nil, fmt.Errorf("rune presets unavailable")
+	}
+
+	return a.runes.List(), nil
+}
+
+// SaveRunePreset adds or replaces a rune preset for a champion and role.
+func (a *App) SaveRunePreset(preset presets.RunePreset) error {
+	if a.runes == nil {
+		return fmt.Errorf("rune presets unavailable")
+	}
+
+	return a.runes.Save(preset)
+}
+
+// DeleteRunePreset removes the rune preset for a champion and role.
+func (a *App) DeleteRunePreset(championID int, position string) error {
+	if a.runes == nil {
+		return fmt.Errorf("rune presets unavailable")
+	}
+
+	return a.runes.Delete(championID, position)
+}
+
+// SaveCurrentRunePage saves the client's current rune page as the preset for a champion and role.
+func (a *App) SaveCurrentRunePage(championID int, position string) (*presets.RunePreset, error) {
+	if a.runes == nil {
+		return nil, fmt.Errorf("rune presets unavailable")
+	}
+
+	client, err := a.lcuClient()
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := client.GetCurrentRunePage()
+	if err != nil {
+		return nil, err
+	}
+
+	preset := presets.RunePreset{
+		ChampionID:      championID,
+		Position:        position,
+		Name:            page.Name,
+		PrimaryStyleID:  page.PrimaryStyleID,
+		SubStyleID:      page.SubStyleID,
+		SelectedPerkIDs: page.SelectedPerkIDs,
+	}
+	if err := a.runes.Save(preset); err != nil {
+		return nil, err
+	}
+	return &preset, nil
+}
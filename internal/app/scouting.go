@@ -0,0 +1,100 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+
+	"lol-toolkit/internal/lcu"
+	"lol-toolkit/internal/scouting"
+)
+
+// setupScouting scouts the local player's team during champ select.
+func (a *App) setupScouting() {
+	a.scout = scouting.New()
+	lcu.SubscribeJSON(a.events, "/lol-champ-select/v1/session", a.handleScoutingSession)
+}
+
+// handleScoutingSession builds a scouting report when the team or their champions change.
+// Reports are built in the background and emitted as "scouting-report".
+func (a *App) handleScoutingSession(event *lcu.Event, session lcu.ChampSelectSession) {
+	if event.EventType == lcu.EventTypeDelete {
+		a.scoutSeq.Add(1) // drop reports still being built
+		a.scout.Reset()
+		a.scoutedLobby = ""
+		a.setScoutingReport(nil)
+		return
+	}
+
+	client := a.riotClient()
+	if client == nil {
+		return
+	}
+
+	teammates := scoutTeammates(session)
+	key := lobbyKey(session.GameID, teammates)
+	if key == a.scoutedLobby {
+		return
+	}
+	a.scoutedLobby = key
+	seq := a.scoutSeq.Add(1)
+
+	go func() {
+		reports := a.scout.Reports(client, teammates)
+		// A newer session update has already started its own report
+		if a.scoutSeq.Load() != seq {
+			return
+		}
+		a.setScoutingReport(reports)
+		runtime.EventsEmit(a.ctx, "scouting-report", map[string]interface{}{
+			"gameId":  session.GameID,
+			"players": reports,
+		})
+	}()
+}
+
+// scoutTeammates lists the players on the local player's team.
+func scoutTeammates(session lcu.ChampSelectSession) []scouting.Teammate {
+	teammates := make([]scouting.Teammate, 0, len(session.MyTeam))
+	for _, player := range session.MyTeam {
+		championID := player.ChampionID
+		if championID == 0 {
+			championID = player.ChampionPickIntent
+		}
+		teammates = append(teammates, scouting.Teammate{
+			CellID:     player.CellID,
+			PUUID:      player.PUUID,
+			Position:   player.AssignedPosition,
+			ChampionID: championID,
+			IsLocal:    player.CellID == session.LocalPlayerCellID,
+		})
+	}
+	return teammates
+}
+
+// lobbyKey identifies a game's teammates and their champions, so timer-only
+// session updates do not rebuild the report.
+func lobbyKey(gameID int64, teammates []scouting.Teammate) string {
+	parts := make([]string, 0, len(teammates)+1)
+	parts = append(parts, fmt.Sprint(gameID))
+	for _, teammate := range teammates {
+		parts = append(parts, fmt.Sprintf("%s:%d", teammate.PUUID, teammate.ChampionID))
+	}
+	return strings.Join(parts, ",")
+}
+
+// setScoutingReport stores the latest report.
+func (a *App) setScoutingReport(reports []*scouting.Report) {
+	a.scoutMu.Lock()
+	defer a.scoutMu.Unlock()
+	a.scoutReports = reports
+}
+
+// GetScoutingReport returns the latest scouting report for the current champ select,
+// or nil outside of champ select.
+func (a *App) GetScoutingReport() []*scouting.Report {
+	a.scoutMu.Lock()
+	defer a.scoutMu.Unlock()
+	return a.scoutReports
+}
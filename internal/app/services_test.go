@@ -0,0 +1,79 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"lol-toolkit/internal/lcu"
+)
+
+// newTestLCU serves a League client that is idle, and makes it the default discoverer.
+func newTestLCU(t *testing.T) *lcu.Client {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/lol-gameflow/v1/gameflow-phase":
+			w.Write([]byte(`"None"`))
+		default:
+			w.Write([]byte(`{}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	u, _ := url.Parse(server.URL)
+	discoverer := lcu.StaticDiscoverer{Host: u.Hostname(), Port: u.Port(), AuthToken: "token"}
+	lcu.SetDefaultDiscoverer(discoverer)
+
+	client, err := lcu.NewClient(discoverer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// TestServicesToggleDuringReconnect toggles the services from one goroutine, as bound
+// methods do, while another resumes them, as the supervisor does on reconnect. Run with -race.
+func TestServicesToggleDuringReconnect(t *testing.T) {
+	client := newTestLCU(t)
+	a := New(nil)
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				a.resumeAutoAccept(client)
+				a.resumeChampSelect(client)
+			}
+		}
+	}()
+
+	for range 20 {
+		if err := a.StartAutoAccept(AutoAcceptConfig{Enabled: true, AutoAccept: true}); err != nil {
+			t.Fatal(err)
+		}
+		if err := a.StartChampSelect(ChampSelectConfig{}); err != nil {
+			t.Fatal(err)
+		}
+		if !a.IsAutoAcceptRunning() || !a.IsChampSelectRunning() {
+			t.Fatal("services not running after start")
+		}
+		a.StopAutoAccept()
+		a.StopChampSelect()
+	}
+
+	close(stop)
+	wg.Wait()
+
+	if a.IsAutoAcceptRunning() || a.IsChampSelectRunning() {
+		t.Fatal("services still running after stop")
+	}
+}
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"sync"
+
+	"lol-toolkit/internal/lol"
+)
+
+// GetActiveGame gets the game a player is currently in, with every player's Riot ID and ranked entries.
+// Returns nil if the player is not in a game.
+func (a *App) GetActiveGame(puuid string) (*lol.ActiveGame, error) {
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
+	}
+
+	game, err := client.GetActiveGame(puuid)
+	if err != nil || game == nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	for _, team := range game.Teams {
+		for _, participant := range team.Participants {
+			if participant.Bot || participant.PUUID == "" {
+				continue
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				enrichParticipant(client, participant)
+			}()
+		}
+	}
+	wg.Wait()
+
+	return game, nil
+}
+
+// enrichParticipant fills in a player's Riot ID and ranked entries. Lookup failures
+// leave the fields empty rather than failing the whole game.
+func enrichParticipant(client *lol.Client, participant *lol.ActiveParticipant) {
+	summoner, err := client.GetSummonerByPUUID(participant.PUUID)
+	if err != nil {
+		return
+	}
+	participant.GameName = summoner.GameName
+	participant.TagLine = summoner.TagLine
+	if participant.SummonerID == "" {
+		participant.SummonerID = summoner.ID
+	}
+
+	if ranked, err := client.GetRankedStats(participant.SummonerID); err == nil {
+		participant.Ranked = ranked
+	}
+}
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"fmt"
+
+	"lol-toolkit/internal/config"
+	"lol-toolkit/internal/presets"
+)
+
+// ListSpellPresets returns all saved summoner spell presets.
+func (a *App) ListSpellPresets() ([]presets.SpellPreset, error) {
+	if a.spells == nil {
+		return nil, fmt.Errorf("spell presets unavailable")
+	}
+
+	return a.spells.List(), nil
+}
+
+// SaveSpellPreset adds or replaces a summoner spell preset for a champion, role and queue.
+func (a *App) SaveSpellPreset(preset presets.SpellPreset) error {
+	if a.spells == nil {
+		return fmt.Errorf("spell presets unavailable")
+	}
+
+	return a.spells.Save(preset)
+}
+
+// DeleteSpellPreset removes the summoner spell preset for a champion, role and queue.
+func (a *App) DeleteSpellPreset(championID int, position string, queueID int) error {
+	if a.spells == nil {
+		return fmt.Errorf("spell presets unavailable")
+	}
+
+	return a.spells.Delete(championID, position, queueID)
+}
+
+// SetSpellSlotOrder updates which slot Flash is placed on when spells are applied.
+func (a *App) SetSpellSlotOrder(order string) error {
+	switch order {
+	case config.SpellSlotOrderAsSaved, config.SpellSlotOrderFlashD, config.SpellSlotOrderFlashF:
+	default:
+		return fmt.Errorf("unknown spell slot order: %s", order)
+	}
+
+	return a.updateConfig(func(cfg *config.Config) (bool, error) {
+		cfg.SpellSlotOrder = order
+		return false, nil
+	})
+}
@@ -1,34 +1,35 @@
 package app
 
 import (
-	"fmt"
-
 	"lol-toolkit/internal/lol"
 )
 
 // SearchSummoner searches for a summoner by Riot ID (gameName#tagLine)
 func (a *App) SearchSummoner(riotID string) (*lol.SummonerInfo, error) {
-	if a.lolClient == nil {
-		return nil, fmt.Errorf("API client not initialized. Please set your API key first")
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
 	}
 
-	return a.lolClient.SearchByRiotID(riotID)
+	return client.SearchByRiotID(riotID)
 }
 
 // GetSummonerByPUUID searches for a summoner by PUUID
 func (a *App) GetSummonerByPUUID(puuid string) (*lol.SummonerInfo, error) {
-	if a.lolClient == nil {
-		return nil, fmt.Errorf("API client not initialized. Please set your API key first")
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
 	}
 
-	return a.lolClient.GetSummonerByPUUID(puuid)
+	return client.GetSummonerByPUUID(puuid)
 }
 
 // GetSummonerByID searches for a summoner by summoner ID
 func (a *App) GetSummonerByID(summonerID string) (*lol.SummonerInfo, error) {
-	if a.lolClient == nil {
-		return nil, fmt.Errorf("API client not initialized. Please set your API key first")
+	client := a.riotClient()
+	if client == nil {
+		return nil, errNotConfigured
 	}
 
-	return a.lolClient.GetSummonerByID(summonerID)
+	return client.GetSummonerByID(summonerID)
 }
@@ -0,0 +1,155 @@
+// Package cache stores API responses with a per-entry expiry in a size-bounded
+// in-memory LRU, optionally backed by a Store that survives restarts.
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Entry is a cached value and its expiry.
+type Entry struct {
+	Value     []byte `json:"value"`
+	ExpiresAt int64  `json:"expiresAt"` // unix milliseconds, 0 if it never expires
+}
+
+// Expired reports whether the entry has expired at now.
+func (e Entry) Expired(now time.Time) bool {
+	return e.ExpiresAt != 0 && now.UnixMilli() >= e.ExpiresAt
+}
+
+// Store is a backing store for entries evicted from or missing in memory.
+type Store interface {
+	Get(key string) (Entry, bool)
+	Set(key string, entry Entry) error
+	Delete(key string) error
+	Clear() error
+	Close() error
+}
+
+// Cache is an LRU cache of byte values. It is safe for concurrent use.
+type Cache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List // most recently used at the front
+	store    Store      // optional
+}
+
+// item is an element of Cache.order.
+type item struct {
+	key   string
+	entry Entry
+}
+
+// New creates a cache holding up to capacity entries in memory.
+// If store is not nil, entries are also written to it and read back on a memory miss.
+func New(capacity int, store Store) *Cache {
+	return &Cache{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+		store:    store,
+	}
+}
+
+// Get returns the value for key if it is cached and has not expired.
+func (c *Cache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if element, ok := c.items[key]; ok {
+		entry := element.Value.(*item).entry
+		if !entry.Expired(now) {
+			c.order.MoveToFront(element)
+			return entry.Value, true
+		}
+		c.remove(element)
+	}
+
+	if c.store == nil {
+		return nil, false
+	}
+	entry, ok := c.store.Get(key)
+	if !ok {
+		return nil, false
+	}
+	if entry.Expired(now) {
+		c.store.Delete(key)
+		return nil, false
+	}
+	c.add(key, entry)
+	return entry.Value, true
+}
+
+// Set caches value for ttl. A ttl of 0 keeps the value until it is evicted.
+func (c *Cache) Set(key string, value []byte, ttl time.Duration) {
+	entry := Entry{Value: value}
+	if ttl > 0 {
+		entry.ExpiresAt = time.Now().Add(ttl).UnixMilli()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.items[key]; ok {
+		c.remove(element)
+	}
+	c.add(key, entry)
+
+	// The store is best effort: a failed write only costs a network call later
+	if c.store != nil {
+		c.store.Set(key, entry)
+	}
+}
+
+// Len returns the number of entries held in memory.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Clear removes every entry from memory and the backing store.
+func (c *Cache) Clear() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = make(map[string]*list.Element)
+	c.order.Init()
+	if c.store != nil {
+		return c.store.Clear()
+	}
+	return nil
+}
+
+// Close closes the backing store.
+func (c *Cache) Close() error {
+	if c.store != nil {
+		return c.store.Close()
+	}
+	return nil
+}
+
+// add inserts an entry at the front, evicting the least recently used entries
+// over capacity. Evicted entries stay in the backing store. The caller must hold c.mu.
+func (c *Cache) add(key string, entry Entry) {
+	c.items[key] = c.order.PushFront(&item{key: key, entry: entry})
+	for c.capacity > 0 && c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*item).key)
+	}
+}
+
+// remove drops an element from memory and the backing store. The caller must hold c.mu.
+func (c *Cache) remove(element *list.Element) {
+	key := element.Value.(*item).key
+	c.order.Remove(element)
+	delete(c.items, key)
+	if c.store != nil {
+		c.store.Delete(key)
+	}
+}
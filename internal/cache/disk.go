@@ -0,0 +1,113 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// diskFileName is the cache database file inside the config directory.
+const diskFileName = "cache.db"
+
+// bucketEntries holds key -> Entry.
+var bucketEntries = []byte("entries")
+
+// DiskStore is a Store kept in a bbolt database.
+type DiskStore struct {
+	bolt *bolt.DB
+}
+
+// OpenDisk opens (or creates) the on-disk cache in dir and drops expired entries.
+func OpenDisk(dir string) (*DiskStore, error) {
+	db, err := bolt.Open(filepath.Join(dir, diskFileName), 0644, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cache database: %w", err)
+	}
+
+	store := &DiskStore{bolt: db}
+	if err := store.purgeExpired(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize cache database: %w", err)
+	}
+	return store, nil
+}
+
+// Get returns the entry for key.
+func (s *DiskStore) Get(key string) (Entry, bool) {
+	var entry Entry
+	found := false
+	s.bolt.View(func(tx *bolt.Tx) error {
+		if data := tx.Bucket(bucketEntries).Get([]byte(key)); data != nil {
+			found = json.Unmarshal(data, &entry) == nil
+		}
+		return nil
+	})
+	return entry, found
+}
+
+// Set stores the entry for key.
+func (s *DiskStore) Set(key string, entry Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return s.bolt.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketEntries).Put([]byte(key), data)
+	})
+}
+
+// Delete removes the entry for key.
+func (s *DiskStore) Delete(key string) error {
+	return s.bolt.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketEntries).Delete([]byte(key))
+	})
+}
+
+// Clear removes every entry.
+func (s *DiskStore) Clear() error {
+	return s.bolt.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(bucketEntries); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucket(bucketEntries)
+		return err
+	})
+}
+
+// Close closes the database.
+func (s *DiskStore) Close() error {
+	return s.bolt.Close()
+}
+
+// purgeExpired creates the bucket if needed and deletes expired entries.
+func (s *DiskStore) purgeExpired() error {
+	now := time.Now()
+	return s.bolt.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(bucketEntries)
+		if err != nil {
+			return err
+		}
+
+		var expired [][]byte
+		err = bucket.ForEach(func(key, data []byte) error {
+			var entry Entry
+			if json.Unmarshal(data, &entry) != nil || entry.Expired(now) {
+				expired = append(expired, append([]byte(nil), key...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, key := range expired {
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,106 @@
+package config
+
+import (
+	"errors"
+
+	"lol-toolkit/internal/secrets"
+)
+
+// apiKeyRef is the secret store name of a profile's API key.
+func apiKeyRef(profile string) string {
+	return "riot-api-key/" + profile
+}
+
+// secretStore opens the secret store in the config directory on first use.
+func (c *Config) secretStore() (secrets.Store, error) {
+	if c.secrets == nil {
+		dir, err := Dir()
+		if err != nil {
+			return nil, err
+		}
+		c.secrets = secrets.Open(dir)
+	}
+	return c.secrets, nil
+}
+
+// SecretBackend names where API keys are stored ("keyring" or "file").
+func (c *Config) SecretBackend() string {
+	store, err := c.secretStore()
+	if err != nil {
+		return ""
+	}
+	return store.Backend()
+}
+
+// loadAPIKeys reads the API key of every profile that references one in the secret store.
+// Keys that are already set, e.g. plaintext keys being migrated, are kept.
+func (c *Config) loadAPIKeys() {
+	c.stored = make(map[string]string)
+
+	store, err := c.secretStore()
+	if err != nil {
+		return
+	}
+
+	for _, p := range c.Profiles {
+		if p.RiotAPIKey != "" || p.APIKeyRef == "" {
+			continue
+		}
+		key, getErr := store.Get(p.APIKeyRef)
+		switch {
+		case getErr == nil:
+			p.RiotAPIKey = key
+			c.stored[p.APIKeyRef] = key
+		case errors.Is(getErr, secrets.ErrNotFound):
+			p.APIKeyRef = ""
+		}
+		// any other error (e.g. a locked keyring) keeps the reference for the next start
+	}
+}
+
+// saveAPIKeys writes changed API keys to the secret store and removes the ones
+// whose profile is gone or whose key was cleared.
+func (c *Config) saveAPIKeys() error {
+	store, err := c.secretStore()
+	if err != nil {
+		return err
+	}
+	if c.stored == nil {
+		c.stored = make(map[string]string)
+	}
+
+	referenced := make(map[string]bool)
+	for _, p := range c.Profiles {
+		if p.RiotAPIKey == "" {
+			if _, loaded := c.stored[p.APIKeyRef]; !loaded && p.APIKeyRef != "" {
+				referenced[p.APIKeyRef] = true // stored but could not be read, keep it
+				continue
+			}
+			p.APIKeyRef = ""
+			continue
+		}
+
+		p.APIKeyRef = apiKeyRef(p.Name)
+		referenced[p.APIKeyRef] = true
+		if c.stored[p.APIKeyRef] == p.RiotAPIKey {
+			continue
+		}
+		if err := store.Set(p.APIKeyRef, p.RiotAPIKey); err != nil {
+			return err
+		}
+		c.stored[p.APIKeyRef] = p.RiotAPIKey
+	}
+
+	var errs []error
+	for ref := range c.stored {
+		if referenced[ref] {
+			continue
+		}
+		if err := store.Delete(ref); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		delete(c.stored, ref)
+	}
+	return errors.Join(errs...)
+}
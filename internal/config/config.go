@@ -1,93 +1,320 @@
 package config
 
 import (
+	"bytes"
+	"crypto/sha256"
 	_ "embed"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+
+	"lol-toolkit/internal/secrets"
 )
 
-// Embed the config file into the binary
-// Create internal/config/config.json with your API key before building
+// embeddedConfig is the config layer built into the binary, between the defaults and the
+// user config file. Builds can change it to ship other defaults; it only accepts the fields
+// of embeddedLayer, so no API key or LCU auth token can end up in the executable.
 //
-//go:embed config.json
+//go:embed embedded.json
 var embeddedConfig []byte
 
-// Config holds the application configuration
+// Config holds the application configuration.
+// API keys are kept in the secret store; the config file only references them.
 type Config struct {
+	Version        int        `json:"version"` // schema version, see CurrentVersion
+	RiotAPIKey     string     `json:"-"`       // active profile's key
+	Region         string     `json:"region"`  // active profile's region
+	Profiles       []*Profile `json:"profiles,omitempty"`
+	ActiveProfile  string     `json:"active_profile,omitempty"`
+	LCU            LCUConfig  `json:"lcu"`
+	SpellSlotOrder string     `json:"spell_slot_order,omitempty"` // "", "flash-d" or "flash-f"
+	DiskCache      bool       `json:"disk_cache,omitempty"`       // keep cached Riot API responses between runs
+
+	secrets       secrets.Store        // opened on first use
+	stored        map[string]string    // secret name to the value last written to the store
+	loadedVersion int                  // schema version of the config file before migration
+	overrides     map[string]*override // setting key to the env var or flag that set it
+	embeddedKeys  map[string]bool      // setting keys set by the embedded config
+	fileKeys      map[string]bool      // setting keys present in the config file
+	issues        []Issue              // problems found while loading
+	fileBroken    bool                 // the config file could not be parsed and is kept aside on save
+}
+
+// legacyConfig holds the plaintext API keys of config files written before the secret store.
+type legacyConfig struct {
 	RiotAPIKey string `json:"riot_api_key"`
-	Region     string `json:"region"`
+	Profiles   []struct {
+		Name       string `json:"name"`
+		RiotAPIKey string `json:"riot_api_key"`
+	} `json:"profiles"`
+}
+
+// Summoner spell slot orders
+const (
+	SpellSlotOrderAsSaved = ""        // apply presets exactly as saved
+	SpellSlotOrderFlashD  = "flash-d" // always put Flash on D
+	SpellSlotOrderFlashF  = "flash-f" // always put Flash on F
+)
+
+var spellSlotOrders = []string{SpellSlotOrderAsSaved, SpellSlotOrderFlashD, SpellSlotOrderFlashF}
+
+// LCU discovery strategies accepted in lcu.discovery
+const (
+	DiscoveryProcess  = "process"  // read the port and token from the client's command line
+	DiscoveryLockfile = "lockfile" // read the lockfile in the League install directory
+	DiscoveryStatic   = "static"   // use the configured host, port and auth token
+)
+
+// LCUConfig controls how the League client connection is discovered
+type LCUConfig struct {
+	// Discovery lists strategies in priority order: "process", "lockfile", "static"
+	Discovery  []string `json:"discovery"`
+	LeaguePath string   `json:"league_path,omitempty"` // install directory holding the lockfile
+	Host       string   `json:"host,omitempty"`        // static host, defaults to 127.0.0.1
+	Port       string   `json:"port,omitempty"`        // static port
+	AuthToken  string   `json:"auth_token,omitempty"`  // static auth token
 }
 
 // Default returns a default configuration
 func Default() *Config {
+	cfg := defaults()
+	cfg.ensureActiveProfile()
+	return cfg
+}
+
+// embeddedLayer lists the settings the embedded config may set. They are not secret.
+type embeddedLayer struct {
+	Region string `json:"region"`
+	LCU    struct {
+		Discovery  []string `json:"discovery"`
+		LeaguePath string   `json:"league_path"`
+		Host       string   `json:"host"`
+		Port       string   `json:"port"`
+	} `json:"lcu"`
+}
+
+// withEmbedded returns the defaults with the embedded config applied. An embedded config
+// with other fields is ignored as a whole and reported.
+func withEmbedded() *Config {
+	cfg := defaults()
+	cfg.embeddedKeys = make(map[string]bool)
+
+	var layer embeddedLayer
+	dec := json.NewDecoder(bytes.NewReader(embeddedConfig))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&layer); err != nil {
+		cfg.addIssue("", sourceEmbedded, fmt.Sprintf("embedded config is ignored, it may only set region and lcu values other than auth_token: %v", err))
+		return cfg
+	}
+
+	set := func(key string, field *string, value string) {
+		if value != "" {
+			*field = value
+			cfg.embeddedKeys[key] = true
+		}
+	}
+	set("region", &cfg.Region, layer.Region)
+	set("lcu.league_path", &cfg.LCU.LeaguePath, layer.LCU.LeaguePath)
+	set("lcu.host", &cfg.LCU.Host, layer.LCU.Host)
+	set("lcu.port", &cfg.LCU.Port, layer.LCU.Port)
+	if layer.LCU.Discovery != nil {
+		cfg.LCU.Discovery = layer.LCU.Discovery
+		cfg.embeddedKeys["lcu.discovery"] = true
+	}
+	return cfg
+}
+
+// defaults returns the lowest config layer, before any profile exists.
+func defaults() *Config {
 	return &Config{
+		Version:    CurrentVersion,
 		RiotAPIKey: "",
 		Region:     "vn2", // Vietnam region as default
+		LCU:        DefaultLCU(),
 	}
 }
 
-// LoadEmbedded loads configuration embedded in the binary
-func LoadEmbedded() (*Config, error) {
-	if len(embeddedConfig) == 0 {
-		return Default(), nil
+// DefaultLCU returns the default LCU discovery settings
+func DefaultLCU() LCUConfig {
+	return LCUConfig{
+		Discovery: []string{DiscoveryProcess, DiscoveryLockfile},
 	}
+}
 
-	var cfg Config
-	if err := json.Unmarshal(embeddedConfig, &cfg); err != nil {
-		return Default(), nil
+// Dir returns the app config directory, creating it if needed
+func Dir() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
 	}
 
-	return &cfg, nil
+	appConfigDir := filepath.Join(configDir, "lol-toolkit")
+	if err := os.MkdirAll(appConfigDir, 0755); err != nil {
+		return "", err
+	}
+
+	return appConfigDir, nil
 }
 
 // configPath returns the path to the user config file
 func configPath() (string, error) {
-	configDir, err := os.UserConfigDir()
+	dir, err := Dir()
 	if err != nil {
 		return "", err
 	}
 
-	appConfigDir := filepath.Join(configDir, "lol-toolkit")
-	if err := os.MkdirAll(appConfigDir, 0755); err != nil {
-		return "", err
+	return filepath.Join(dir, "config.json"), nil
+}
+
+// Load builds the config from its layers: defaults, the embedded config, the user config file,
+// LOL_TOOLKIT_* environment variables and command line flags, each overriding the last.
+// API keys are read from the secret store. Older config files are migrated and saved.
+// Problems never stop the app from starting; they are reported by Issues.
+func Load(args []string) *Config {
+	cfg := loadFile(true)
+	cfg.applyOverrides(args)
+	return cfg
+}
+
+// LoadReadOnly builds the config like Load but never writes the config file or the
+// secret store: an older config file is migrated in memory only. It is for inspecting
+// the config, e.g. with `lol-toolkit config doctor`, while the app may be running.
+func LoadReadOnly(args []string) *Config {
+	cfg := loadFile(false)
+	cfg.applyOverrides(args)
+	return cfg
+}
+
+// Reload loads the config again after the file changed outside the app. While the file is
+// not valid JSON, e.g. half way through an edit, current stays in effect and reports the
+// problem; its next save moves the broken file aside rather than overwriting it.
+// It reports whether the returned config replaces current.
+func Reload(current *Config, args []string) (*Config, bool) {
+	cfg := Load(args)
+	if !cfg.fileBroken {
+		return cfg, true
+	}
+
+	isFileIssue := func(issue Issue) bool { return issue.Field == "" && issue.Source == sourceFile }
+	current.issues = slices.DeleteFunc(current.issues, isFileIssue)
+	for _, issue := range cfg.issues {
+		if isFileIssue(issue) {
+			current.issues = append(current.issues, issue)
+		}
 	}
+	current.fileBroken = true
+	return current, false
+}
 
-	return filepath.Join(appConfigDir, "config.json"), nil
+// Clone returns a copy of the config that shares no profiles or slices with it.
+func (c *Config) Clone() *Config {
+	clone := *c
+	clone.LCU.Discovery = slices.Clone(c.LCU.Discovery)
+	clone.Profiles = make([]*Profile, len(c.Profiles))
+	for i, p := range c.Profiles {
+		profile := *p
+		clone.Profiles[i] = &profile
+	}
+	clone.issues = slices.Clone(c.issues)
+	return &clone
 }
 
-// Load loads configuration with priority:
-// 1. User config file (if exists) - allows override
-// 2. Embedded config (compiled into binary)
-// 3. Default config
-func Load() (*Config, error) {
-	// First, try to load user config (allows runtime override)
+// loadFile loads the user config file on top of the defaults and the embedded config.
+// A migrated file is saved back only if save is set.
+func loadFile(save bool) *Config {
+	cfg := withEmbedded()
+
 	path, err := configPath()
+	if err != nil {
+		cfg.ensureActiveProfile()
+		return cfg
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		cfg.ensureActiveProfile()
+		return cfg
+	}
+
+	var legacy legacyConfig
+	cfg.Version = 0
+	err = json.Unmarshal(data, cfg)
 	if err == nil {
-		data, err := os.ReadFile(path)
-		if err == nil {
-			var cfg Config
-			if err := json.Unmarshal(data, &cfg); err == nil {
-				return &cfg, nil
-			}
-		}
+		err = json.Unmarshal(data, &legacy)
+	}
+	if err != nil {
+		cfg = withEmbedded()
+		cfg.ensureActiveProfile()
+		cfg.fileBroken = true
+		cfg.addIssue("", sourceFile, fmt.Sprintf("config file is not valid JSON and was ignored: %v", err))
+		return cfg
 	}
 
-	// Fall back to embedded config
-	return LoadEmbedded()
+	migrated := cfg.migrate(&legacy)
+	cfg.checkFileKeys(data)
+	cfg.loadAPIKeys()
+	cfg.ensureActiveProfile()
+
+	if migrated && save {
+		if err := Save(cfg); err != nil {
+			cfg.addIssue("version", sourceFile, fmt.Sprintf("migrated config could not be saved: %v", err))
+		}
+	}
+	return cfg
 }
 
-// Save saves configuration to the user config file
+// Save saves configuration to the user config file, readable only by the current user.
+// API keys are written to the secret store first. Values set by environment variables
+// or flags are not saved unless they were changed in the app since.
+// A config file that could not be parsed is renamed to config.json.broken rather than overwritten.
 func Save(cfg *Config) error {
 	path, err := configPath()
 	if err != nil {
 		return err
 	}
 
-	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err := cfg.saveAPIKeys(); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(cfg.persisted(), "", "  ")
 	if err != nil {
 		return err
 	}
 
-	return os.WriteFile(path, data, 0644)
+	if cfg.fileBroken {
+		if err := os.Rename(path, path+".broken"); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		cfg.fileBroken = false
+	}
+
+	// stored before the rename, so a watcher check right after it already knows the content is ours
+	savedSum.Store(sha256.Sum256(data))
+	return writeFileAtomic(path, data)
+}
+
+// writeFileAtomic writes data to a temporary file, which CreateTemp makes readable only by
+// the current user, and renames it over path, so a crash or a concurrent reader never sees a truncated file.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
 }
@@ -0,0 +1,123 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"lol-toolkit/internal/secrets"
+)
+
+// DoctorReport explains the effective config: every value, the layer it came from
+// and the problems found.
+type DoctorReport struct {
+	Path          string        `json:"path"`
+	Version       int           `json:"version"`
+	SecretBackend string        `json:"secretBackend"`
+	Values        []DoctorValue `json:"values"`
+	Issues        []Issue       `json:"issues"`
+}
+
+// DoctorValue is an effective setting value and where it came from
+type DoctorValue struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`  // redacted for secrets
+	Source string `json:"source"` // e.g. "default", "embedded config", "config file", "flag --region"
+	Env    string `json:"env"`    // variable that overrides it
+	Flag   string `json:"flag"`   // flag that overrides it
+}
+
+// Doctor builds a report of the effective config.
+func (c *Config) Doctor() *DoctorReport {
+	path, _ := configPath()
+	report := &DoctorReport{
+		Path:          path,
+		Version:       c.Version,
+		SecretBackend: c.SecretBackend(),
+		Values:        make([]DoctorValue, len(settings)),
+		Issues:        c.Issues(),
+	}
+
+	for i, s := range settings {
+		value := s.get(c)
+		if s.secret {
+			value = secrets.Redact(value)
+		}
+		report.Values[i] = DoctorValue{
+			Key:    s.key,
+			Value:  value,
+			Source: c.source(s.key),
+			Env:    s.envName(),
+			Flag:   s.flagName(),
+		}
+	}
+	return report
+}
+
+// source names the layer a setting's effective value came from.
+func (c *Config) source(key string) string {
+	for _, s := range settings {
+		if s.key == key {
+			if o := c.activeOverride(s); o != nil {
+				return o.describe()
+			}
+		}
+	}
+
+	switch {
+	case key == "region" || key == "api_key":
+		// the top-level fields mirror the active profile
+		if c.Profile(c.ActiveProfile) == nil {
+			return sourceDefault
+		}
+		source := fmt.Sprintf("profile %q", c.ActiveProfile)
+		if key == "api_key" && c.RiotAPIKey != "" {
+			source += " (stored in " + c.SecretBackend() + ")"
+		}
+		return source
+	case key == "profile":
+		key = "active_profile"
+	case strings.HasPrefix(key, "profiles."):
+		return sourceFile
+	}
+
+	if c.fileKeys[key] {
+		return sourceFile
+	}
+	if c.embeddedKeys[key] {
+		return sourceEmbedded
+	}
+	return sourceDefault
+}
+
+// WriteText writes the report as plain text, e.g. for `lol-toolkit config doctor`.
+func (r *DoctorReport) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Config file:\t%s\n", r.Path)
+	fmt.Fprintf(tw, "Schema version:\t%d\n", r.Version)
+	fmt.Fprintf(tw, "Key storage:\t%s\n\n", r.SecretBackend)
+
+	fmt.Fprintln(tw, "SETTING\tVALUE\tSOURCE\tOVERRIDE WITH")
+	for _, v := range r.Values {
+		value := v.Value
+		if value == "" {
+			value = "(empty)"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s, %s\n", v.Key, value, v.Source, v.Env, v.Flag)
+	}
+
+	if len(r.Issues) == 0 {
+		fmt.Fprintln(tw, "\nNo problems found.")
+	} else {
+		fmt.Fprintln(tw, "\nPROBLEM\tSOURCE\tDETAILS")
+		for _, issue := range r.Issues {
+			field := issue.Field
+			if field == "" {
+				field = "(file)"
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", field, issue.Source, issue.Message)
+		}
+	}
+	return tw.Flush()
+}
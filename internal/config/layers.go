@@ -0,0 +1,243 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"lol-toolkit/internal/lol"
+)
+
+// envPrefix starts the name of every environment variable that overrides a setting
+const envPrefix = "LOL_TOOLKIT_"
+
+// Value sources, lowest precedence first
+const (
+	sourceDefault  = "default"
+	sourceEmbedded = "embedded config"
+	sourceFile     = "config file"
+	sourceEnv      = "env"
+	sourceFlag     = "flag"
+)
+
+// setting is a config value that environment variables and flags can override.
+// get and set work on the effective value; set rejects values that would not work.
+type setting struct {
+	key     string // dotted config file path, e.g. "lcu.port"
+	secret  bool   // redacted in reports
+	boolean bool   // a bare flag sets it to true
+	get     func(c *Config) string
+	set     func(c *Config, value string) error
+}
+
+// settings lists the overridable settings in the order they are applied.
+// The profile comes first so that region and key overrides apply on top of it.
+var settings = []setting{
+	{
+		key: "profile",
+		get: func(c *Config) string { return c.ActiveProfile },
+		set: func(c *Config, v string) error {
+			if c.Profile(v) == nil {
+				return fmt.Errorf("%w: %q", ErrProfileNotFound, v)
+			}
+			c.ActiveProfile = v
+			c.applyActiveProfile()
+			return nil
+		},
+	},
+	{
+		key: "region",
+		get: func(c *Config) string { return c.Region },
+		set: func(c *Config, v string) error {
+			platform, err := lol.LookupPlatform(v)
+			if err != nil {
+				return err
+			}
+			c.Region = platform.ID
+			return nil
+		},
+	},
+	{
+		key:    "api_key",
+		secret: true,
+		get:    func(c *Config) string { return c.RiotAPIKey },
+		set:    func(c *Config, v string) error { c.RiotAPIKey = strings.TrimSpace(v); return nil },
+	},
+	{
+		key: "spell_slot_order",
+		get: func(c *Config) string { return c.SpellSlotOrder },
+		set: func(c *Config, v string) error {
+			if !slices.Contains(spellSlotOrders, v) {
+				return fmt.Errorf("unknown spell slot order %q", v)
+			}
+			c.SpellSlotOrder = v
+			return nil
+		},
+	},
+	{
+		key:     "disk_cache",
+		boolean: true,
+		get:     func(c *Config) string { return strconv.FormatBool(c.DiskCache) },
+		set: func(c *Config, v string) error {
+			enabled, err := strconv.ParseBool(v)
+			if err != nil {
+				return fmt.Errorf("expected true or false, got %q", v)
+			}
+			c.DiskCache = enabled
+			return nil
+		},
+	},
+	{
+		key: "lcu.discovery",
+		get: func(c *Config) string { return strings.Join(c.LCU.Discovery, ",") },
+		set: func(c *Config, v string) error {
+			var strategies []string
+			for _, s := range strings.Split(v, ",") {
+				if s = strings.TrimSpace(s); s != "" {
+					strategies = append(strategies, s)
+				}
+			}
+			c.LCU.Discovery = strategies
+			return nil
+		},
+	},
+	{
+		key: "lcu.league_path",
+		get: func(c *Config) string { return c.LCU.LeaguePath },
+		set: func(c *Config, v string) error { c.LCU.LeaguePath = v; return nil },
+	},
+	{
+		key: "lcu.host",
+		get: func(c *Config) string { return c.LCU.Host },
+		set: func(c *Config, v string) error { c.LCU.Host = v; return nil },
+	},
+	{
+		key: "lcu.port",
+		get: func(c *Config) string { return c.LCU.Port },
+		set: func(c *Config, v string) error { c.LCU.Port = v; return nil },
+	},
+	{
+		key:    "lcu.auth_token",
+		secret: true,
+		get:    func(c *Config) string { return c.LCU.AuthToken },
+		set:    func(c *Config, v string) error { c.LCU.AuthToken = v; return nil },
+	},
+}
+
+// envName returns the environment variable that overrides a setting, e.g. LOL_TOOLKIT_LCU_PORT.
+func (s setting) envName() string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(s.key, ".", "_"))
+}
+
+// flagName returns the command line flag that overrides a setting, e.g. --lcu-port.
+func (s setting) flagName() string {
+	return "--" + strings.NewReplacer(".", "-", "_", "-").Replace(s.key)
+}
+
+// override is a setting value that came from an environment variable or flag
+type override struct {
+	source string // sourceEnv or sourceFlag
+	name   string // variable or flag name
+	value  string // value it set
+	base   string // value from the lower layers, which is what gets saved
+}
+
+// describe names where an overridden value came from, e.g. "env LOL_TOOLKIT_REGION".
+func (o *override) describe() string {
+	return o.source + " " + o.name
+}
+
+// applyOverrides applies environment variables, then flags. A value a setting rejects
+// is reported as an issue and leaves the lower layer in effect.
+func (c *Config) applyOverrides(args []string) {
+	c.overrides = make(map[string]*override)
+	flags := parseFlags(args)
+
+	for _, s := range settings {
+		if value, ok := os.LookupEnv(s.envName()); ok {
+			c.applyOverride(s, &override{source: sourceEnv, name: s.envName(), value: value})
+		}
+		if value, ok := flags[s.flagName()]; ok {
+			c.applyOverride(s, &override{source: sourceFlag, name: s.flagName(), value: value})
+		}
+	}
+}
+
+func (c *Config) applyOverride(s setting, o *override) {
+	base := s.get(c)
+	if previous := c.overrides[s.key]; previous != nil {
+		base = previous.base
+	}
+
+	if err := s.set(c, o.value); err != nil {
+		c.addIssue(s.key, o.describe(), err.Error())
+		return
+	}
+	o.value = s.get(c) // normalized, e.g. the region "EUW1" becomes "euw1"
+	o.base = base
+	c.overrides[s.key] = o
+}
+
+// parseFlags reads --name=value and --name value flags. Arguments that are not flags are
+// skipped, as the app also receives arguments meant for Wails. A flag without a value,
+// or followed by another flag, is "true".
+func parseFlags(args []string) map[string]string {
+	flags := make(map[string]string)
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "--") {
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(arg, "=")
+		if !hasValue {
+			value = "true"
+			if s := settingByFlag(name); s != nil && !s.boolean && i+1 < len(args) && !strings.HasPrefix(args[i+1], "--") {
+				i++
+				value = args[i]
+			}
+		}
+		flags[name] = value
+	}
+	return flags
+}
+
+func settingByFlag(name string) *setting {
+	for i := range settings {
+		if settings[i].flagName() == name {
+			return &settings[i]
+		}
+	}
+	return nil
+}
+
+// activeOverride returns the override of a setting if its value is still in effect.
+// Once a value is changed in the app, e.g. by switching profiles, the change wins.
+func (c *Config) activeOverride(s setting) *override {
+	o := c.overrides[s.key]
+	if o == nil || s.get(c) != o.value {
+		return nil
+	}
+	return o
+}
+
+// persisted returns the config as it is saved: overridden values are replaced by
+// what the lower layers set, and overrides changed in the app since are dropped.
+func (c *Config) persisted() *Config {
+	saved := *c
+	saved.Version = CurrentVersion
+	for _, s := range slices.Backward(settings) {
+		if _, ok := c.overrides[s.key]; !ok {
+			continue
+		}
+		o := c.activeOverride(s)
+		if o == nil {
+			delete(c.overrides, s.key)
+			continue
+		}
+		s.set(&saved, o.base)
+	}
+	return &saved
+}
@@ -0,0 +1,148 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"lol-toolkit/internal/apierr"
+	"lol-toolkit/internal/secrets"
+)
+
+// DefaultProfileName is the profile a config without profiles is migrated to
+const DefaultProfileName = "default"
+
+// Profile errors
+var (
+	ErrProfileNotFound = apierr.New(apierr.CodeNotFound, "profile not found")
+	ErrProfileExists   = apierr.New(apierr.CodeBadRequest, "profile already exists")
+	ErrLastProfile     = apierr.New(apierr.CodeBadRequest, "cannot delete the only profile")
+)
+
+// Profile is a named Riot API key and region, e.g. one per account or server
+type Profile struct {
+	Name       string `json:"name"`
+	RiotAPIKey string `json:"-"`
+	APIKeyRef  string `json:"api_key_ref,omitempty"` // name of the key in the secret store
+	Region     string `json:"region"`
+}
+
+// ProfileInfo describes a profile for the frontend without its API key
+type ProfileInfo struct {
+	Name       string `json:"name"`
+	Region     string `json:"region"`
+	HasAPIKey  bool   `json:"hasApiKey"`
+	APIKeyHint string `json:"apiKeyHint"` // redacted key
+	Active     bool   `json:"active"`
+}
+
+// ensureActiveProfile makes sure the config has a profile and the active pointer names one.
+// Without profiles, the top-level key and region become the "default" profile.
+func (c *Config) ensureActiveProfile() {
+	if len(c.Profiles) == 0 {
+		c.Profiles = []*Profile{{
+			Name:       DefaultProfileName,
+			RiotAPIKey: c.RiotAPIKey,
+			Region:     c.Region,
+		}}
+		c.ActiveProfile = DefaultProfileName
+	}
+
+	if c.Profile(c.ActiveProfile) == nil {
+		c.ActiveProfile = c.Profiles[0].Name
+	}
+	c.applyActiveProfile()
+}
+
+// applyActiveProfile copies the active profile's key and region to the top-level fields,
+// which the rest of the app reads and older versions of the app still understand.
+func (c *Config) applyActiveProfile() {
+	if p := c.Profile(c.ActiveProfile); p != nil {
+		c.RiotAPIKey = p.RiotAPIKey
+		c.Region = p.Region
+	}
+}
+
+// Profile returns the profile with the given name, or nil if there is none.
+func (c *Config) Profile(name string) *Profile {
+	for _, p := range c.Profiles {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// ProfileInfos lists the profiles in the order they were created.
+func (c *Config) ProfileInfos() []ProfileInfo {
+	infos := make([]ProfileInfo, len(c.Profiles))
+	for i, p := range c.Profiles {
+		infos[i] = ProfileInfo{
+			Name:       p.Name,
+			Region:     p.Region,
+			HasAPIKey:  p.RiotAPIKey != "",
+			APIKeyHint: secrets.Redact(p.RiotAPIKey),
+			Active:     p.Name == c.ActiveProfile,
+		}
+	}
+	return infos
+}
+
+// AddProfile adds a profile. Names are trimmed and must be unique.
+func (c *Config) AddProfile(name, apiKey, region string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return apierr.New(apierr.CodeBadRequest, "profile name is required")
+	}
+	if c.Profile(name) != nil {
+		return fmt.Errorf("%w: %q", ErrProfileExists, name)
+	}
+
+	c.Profiles = append(c.Profiles, &Profile{Name: name, RiotAPIKey: apiKey, Region: region})
+	return nil
+}
+
+// SwitchProfile makes the named profile active.
+func (c *Config) SwitchProfile(name string) error {
+	if c.Profile(name) == nil {
+		return fmt.Errorf("%w: %q", ErrProfileNotFound, name)
+	}
+
+	c.ActiveProfile = name
+	c.applyActiveProfile()
+	return nil
+}
+
+// DeleteProfile removes a profile. Deleting the active profile activates the first remaining one.
+func (c *Config) DeleteProfile(name string) error {
+	index := slices.IndexFunc(c.Profiles, func(p *Profile) bool { return p.Name == name })
+	if index < 0 {
+		return fmt.Errorf("%w: %q", ErrProfileNotFound, name)
+	}
+	if len(c.Profiles) == 1 {
+		return ErrLastProfile
+	}
+
+	c.Profiles = slices.Delete(c.Profiles, index, index+1)
+	if c.ActiveProfile == name {
+		c.ActiveProfile = c.Profiles[0].Name
+		c.applyActiveProfile()
+	}
+	return nil
+}
+
+// SetAPIKey sets the Riot API key of the active profile.
+func (c *Config) SetAPIKey(apiKey string) {
+	c.RiotAPIKey = apiKey
+	if p := c.Profile(c.ActiveProfile); p != nil {
+		p.RiotAPIKey = apiKey
+	}
+}
+
+// SetRegion sets the region of the active profile.
+func (c *Config) SetRegion(region string) {
+	c.Region = region
+	if p := c.Profile(c.ActiveProfile); p != nil {
+		p.Region = region
+	}
+}
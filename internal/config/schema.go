@@ -0,0 +1,140 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// CurrentVersion is the config file schema version written by this build.
+//
+//	1: a single plaintext API key and region
+//	2: named profiles
+//	3: API keys in the secret store
+const CurrentVersion = 3
+
+// migrations upgrade a config one schema version at a time; migrations[v-1] upgrades version v.
+var migrations = []func(*Config, *legacyConfig){
+	migrateSingleKey,
+	migratePlaintextKeys,
+}
+
+// migrate upgrades a config read from a file to CurrentVersion and reports whether it changed.
+// Files written before the version field are version 1 without profiles and 2 with them.
+func (c *Config) migrate(legacy *legacyConfig) bool {
+	if c.Version == 0 {
+		c.Version = 1
+		if len(c.Profiles) > 0 {
+			c.Version = 2
+		}
+	}
+	c.loadedVersion = c.Version
+	if c.Version > CurrentVersion {
+		c.addIssue("version", sourceFile, fmt.Sprintf(
+			"config file was written by a newer version of the app (schema %d, this build reads %d)", c.Version, CurrentVersion))
+		return false
+	}
+
+	from := c.Version
+	for c.Version < CurrentVersion {
+		migrations[c.Version-1](c, legacy)
+		c.Version++
+	}
+	return c.Version != from
+}
+
+// migrateSingleKey turns the single key and region into the "default" profile.
+func migrateSingleKey(c *Config, legacy *legacyConfig) {
+	c.RiotAPIKey = legacy.RiotAPIKey
+	c.ensureActiveProfile()
+}
+
+// migratePlaintextKeys picks up the plaintext profile keys, which the next save
+// moves into the secret store.
+func migratePlaintextKeys(c *Config, legacy *legacyConfig) {
+	for _, lp := range legacy.Profiles {
+		if p := c.Profile(lp.Name); p != nil && p.RiotAPIKey == "" {
+			p.RiotAPIKey = lp.RiotAPIKey
+		}
+	}
+}
+
+// legacyFields are the config file fields only older schema versions have.
+var legacyFields = map[string]int{
+	"riot_api_key":          2, // last version with the field
+	"profiles.riot_api_key": 2,
+}
+
+// checkFileKeys records which settings the config file sets and reports the fields
+// this version of the schema does not know, e.g. misspelled ones.
+func (c *Config) checkFileKeys(data []byte) {
+	c.fileKeys = make(map[string]bool)
+
+	var raw map[string]json.RawMessage
+	if json.Unmarshal(data, &raw) != nil {
+		return
+	}
+
+	var unknown []string
+	for _, name := range sortedKeys(raw) {
+		switch name {
+		case "lcu":
+			var lcu map[string]json.RawMessage
+			json.Unmarshal(raw[name], &lcu)
+			for _, field := range sortedKeys(lcu) {
+				c.fileKeys["lcu."+field] = true
+				if !hasJSONField(reflect.TypeFor[LCUConfig](), field) {
+					unknown = append(unknown, "lcu."+field)
+				}
+			}
+		case "profiles":
+			var profiles []map[string]json.RawMessage
+			json.Unmarshal(raw[name], &profiles)
+			for i, profile := range profiles {
+				for _, field := range sortedKeys(profile) {
+					if !hasJSONField(reflect.TypeFor[Profile](), field) && !c.isLegacyField("profiles."+field) {
+						unknown = append(unknown, fmt.Sprintf("profiles[%d].%s", i, field))
+					}
+				}
+			}
+		}
+
+		c.fileKeys[name] = true
+		if !hasJSONField(reflect.TypeFor[Config](), name) && !c.isLegacyField(name) {
+			unknown = append(unknown, name)
+		}
+	}
+
+	for _, field := range unknown {
+		c.addIssue(field, sourceFile, "unknown field, it is ignored")
+	}
+}
+
+// isLegacyField reports whether a field belongs to the schema version the file was written with.
+func (c *Config) isLegacyField(name string) bool {
+	last, ok := legacyFields[name]
+	return ok && c.loadedVersion <= last
+}
+
+// hasJSONField reports whether a struct type has a field encoded under the given JSON name.
+func hasJSONField(t reflect.Type, name string) bool {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if field.IsExported() && tag != "-" && tag == name {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
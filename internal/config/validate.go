@@ -0,0 +1,77 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"lol-toolkit/internal/lol"
+)
+
+// apiKeyRe matches the format of Riot API keys, e.g. RGAPI-xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+var apiKeyRe = regexp.MustCompile(`^RGAPI-[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// discoveryStrategies are the values lcu.discovery accepts
+var discoveryStrategies = []string{DiscoveryProcess, DiscoveryLockfile, DiscoveryStatic}
+
+// Issue is a config problem for the frontend. The app still starts, using a fallback
+// for the value, but the user should fix it.
+type Issue struct {
+	Field   string `json:"field"` // setting key, e.g. "lcu.port"; empty for the whole file
+	Message string `json:"message"`
+	Source  string `json:"source"` // layer the value came from, e.g. "config file" or "env LOL_TOOLKIT_REGION"
+}
+
+func (c *Config) addIssue(field, source, message string) {
+	c.issues = append(c.issues, Issue{Field: field, Message: message, Source: source})
+}
+
+// Issues returns the problems found while loading and the ones the current values have.
+func (c *Config) Issues() []Issue {
+	issues := append([]Issue{}, c.issues...)
+	return append(issues, c.Validate()...)
+}
+
+// Validate checks the effective values and every profile.
+func (c *Config) Validate() []Issue {
+	var issues []Issue
+	add := func(field, message string) {
+		issues = append(issues, Issue{Field: field, Message: message, Source: c.source(field)})
+	}
+
+	if _, err := lol.LookupPlatform(c.Region); err != nil {
+		add("region", err.Error())
+	}
+	if c.RiotAPIKey != "" && !apiKeyRe.MatchString(c.RiotAPIKey) {
+		add("api_key", "malformed API key, expected RGAPI- followed by a UUID")
+	}
+	for _, p := range c.Profiles {
+		if _, err := lol.LookupPlatform(p.Region); err != nil {
+			add(fmt.Sprintf("profiles.%s.region", p.Name), err.Error())
+		}
+		if p.RiotAPIKey != "" && p.RiotAPIKey != c.RiotAPIKey && !apiKeyRe.MatchString(p.RiotAPIKey) {
+			add(fmt.Sprintf("profiles.%s.api_key", p.Name), "malformed API key, expected RGAPI- followed by a UUID")
+		}
+	}
+
+	if !slices.Contains(spellSlotOrders, c.SpellSlotOrder) {
+		add("spell_slot_order", fmt.Sprintf("unknown spell slot order %q, Flash is placed as saved", c.SpellSlotOrder))
+	}
+
+	for _, strategy := range c.LCU.Discovery {
+		if !slices.Contains(discoveryStrategies, strategy) {
+			add("lcu.discovery", fmt.Sprintf("unknown discovery strategy %q, expected one of %q", strategy, discoveryStrategies))
+		}
+	}
+	if slices.Contains(c.LCU.Discovery, DiscoveryStatic) {
+		if port, err := strconv.Atoi(c.LCU.Port); err != nil || port < 1 || port > 65535 {
+			add("lcu.port", fmt.Sprintf("static discovery needs a port between 1 and 65535, got %q", c.LCU.Port))
+		}
+		if c.LCU.AuthToken == "" {
+			add("lcu.auth_token", "static discovery needs an auth token")
+		}
+	}
+
+	return issues
+}
@@ -0,0 +1,133 @@
+package config
+
+import (
+	"crypto/sha256"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultWatchInterval is how often the config file is checked for changes.
+const DefaultWatchInterval = 2 * time.Second
+
+// savedSum is the checksum of the config file this process last wrote,
+// so the watcher can tell the app's own saves from outside edits.
+var savedSum atomic.Value // [sha256.Size]byte
+
+// Watcher polls the user config file and reports changes made outside the app,
+// e.g. by hand or by another instance of the toolkit.
+type Watcher struct {
+	interval time.Duration
+	onChange func()
+	last     fileState
+	mu       sync.Mutex
+	stop     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// fileState identifies a version of the config file
+type fileState struct {
+	modTime time.Time
+	size    int64
+	sum     [sha256.Size]byte
+}
+
+// NewWatcher creates a watcher that calls onChange after the config file changes.
+func NewWatcher(interval time.Duration, onChange func()) *Watcher {
+	return &Watcher{
+		interval: interval,
+		onChange: onChange,
+	}
+}
+
+// Start starts watching from the file's current state. It does nothing if the watcher is already running.
+func (w *Watcher) Start() {
+	w.mu.Lock()
+	if w.stop != nil {
+		w.mu.Unlock()
+		return
+	}
+	w.stop = make(chan struct{})
+	stop := w.stop
+	w.last, _ = readFileState(w.last)
+	w.mu.Unlock()
+
+	w.wg.Add(1)
+	go w.run(stop)
+}
+
+// Stop stops watching.
+func (w *Watcher) Stop() {
+	w.mu.Lock()
+	stop := w.stop
+	w.stop = nil
+	w.mu.Unlock()
+
+	if stop == nil {
+		return
+	}
+
+	close(stop)
+	w.wg.Wait()
+}
+
+// run checks the file until stop is closed.
+func (w *Watcher) run(stop chan struct{}) {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			w.check()
+		}
+	}
+}
+
+// check reports the file as changed if its content differs from the last state seen
+// and from what the app itself last saved. A deleted file is not a change.
+func (w *Watcher) check() {
+	w.mu.Lock()
+	state, changed := readFileState(w.last)
+	w.last = state
+	w.mu.Unlock()
+
+	if !changed {
+		return
+	}
+	if saved, ok := savedSum.Load().([sha256.Size]byte); ok && saved == state.sum {
+		return
+	}
+	if w.onChange != nil {
+		w.onChange()
+	}
+}
+
+// readFileState reads the config file if its size or modification time differ from last,
+// and reports whether its content changed.
+func readFileState(last fileState) (fileState, bool) {
+	path, err := configPath()
+	if err != nil {
+		return last, false
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return last, false
+	}
+	if info.ModTime().Equal(last.modTime) && info.Size() == last.size {
+		return last, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return last, false
+	}
+	state := fileState{modTime: info.ModTime(), size: info.Size(), sum: sha256.Sum256(data)}
+	return state, state.sum != last.sum
+}
@@ -0,0 +1,253 @@
+// Package history persists match history and ranked snapshots for tracked accounts,
+// so they can be browsed and analyzed without calling the Riot API.
+package history
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"lol-toolkit/internal/lol"
+)
+
+// dbFileName is the database file inside the config directory.
+const dbFileName = "history.db"
+
+// Bucket names
+var (
+	bucketAccounts      = []byte("accounts")       // puuid -> TrackedAccount
+	bucketMatches       = []byte("matches")        // match ID -> lol.MatchInfo
+	bucketPlayerMatches = []byte("player_matches") // puuid -> {game creation + match ID -> nil}
+	bucketRanked        = []byte("ranked")         // puuid -> {timestamp -> RankedSnapshot}
+)
+
+// TrackedAccount is an account whose match history is synced.
+type TrackedAccount struct {
+	PUUID        string `json:"puuid"`
+	GameName     string `json:"gameName"`
+	TagLine      string `json:"tagLine"`
+	LastSyncedAt int64  `json:"lastSyncedAt"` // unix milliseconds, 0 if never synced
+
+	// LastSyncedCreation is the creation time (unix milliseconds) of the newest match a sync
+	// stored for the account. Only syncs advance it; matches stored when viewed do not.
+	LastSyncedCreation int64 `json:"lastSyncedCreation"`
+}
+
+// DB is the local match database.
+type DB struct {
+	bolt    *bolt.DB
+	syncing sync.Mutex // held while a sync runs
+}
+
+// Open opens (or creates) the match database in dir.
+func Open(dir string) (*DB, error) {
+	db, err := bolt.Open(filepath.Join(dir, dbFileName), 0644, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open match database: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{bucketAccounts, bucketMatches, bucketPlayerMatches, bucketRanked} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize match database: %w", err)
+	}
+
+	return &DB{bolt: db}, nil
+}
+
+// Close closes the database.
+func (db *DB) Close() error {
+	return db.bolt.Close()
+}
+
+// TrackAccount adds or updates a tracked account, keeping its sync state.
+func (db *DB) TrackAccount(account TrackedAccount) error {
+	return db.bolt.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketAccounts)
+
+		var existing TrackedAccount
+		if data := bucket.Get([]byte(account.PUUID)); data != nil && json.Unmarshal(data, &existing) == nil {
+			account.LastSyncedAt = existing.LastSyncedAt
+			account.LastSyncedCreation = existing.LastSyncedCreation
+		}
+		return putJSON(bucket, []byte(account.PUUID), account)
+	})
+}
+
+// UntrackAccount stops syncing an account. Stored matches are kept.
+func (db *DB) UntrackAccount(puuid string) error {
+	return db.bolt.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketAccounts).Delete([]byte(puuid))
+	})
+}
+
+// TrackedAccounts returns all tracked accounts.
+func (db *DB) TrackedAccounts() ([]TrackedAccount, error) {
+	accounts := []TrackedAccount{}
+	err := db.bolt.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketAccounts).ForEach(func(_, data []byte) error {
+			var account TrackedAccount
+			if err := json.Unmarshal(data, &account); err != nil {
+				return err
+			}
+			accounts = append(accounts, account)
+			return nil
+		})
+	})
+	return accounts, err
+}
+
+// markSynced records the time an account was last synced.
+func (db *DB) markSynced(puuid string, at time.Time) error {
+	return db.bolt.Update(func(tx *bolt.Tx) error {
+		return updateAccount(tx, puuid, func(account *TrackedAccount) {
+			account.LastSyncedAt = at.UnixMilli()
+		})
+	})
+}
+
+// updateAccount changes a tracked account. It does nothing if the account is not tracked.
+func updateAccount(tx *bolt.Tx, puuid string, change func(account *TrackedAccount)) error {
+	bucket := tx.Bucket(bucketAccounts)
+
+	var account TrackedAccount
+	data := bucket.Get([]byte(puuid))
+	if data == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, &account); err != nil {
+		return err
+	}
+	change(&account)
+	return putJSON(bucket, []byte(puuid), account)
+}
+
+// SaveMatch stores a match and indexes it for every participant.
+func (db *DB) SaveMatch(match *lol.MatchInfo) error {
+	return db.bolt.Update(func(tx *bolt.Tx) error {
+		return putMatch(tx, match)
+	})
+}
+
+// saveSyncedMatch stores a match synced for a tracked account and advances the
+// account's sync watermark in the same transaction.
+func (db *DB) saveSyncedMatch(puuid string, match *lol.MatchInfo) error {
+	return db.bolt.Update(func(tx *bolt.Tx) error {
+		if err := putMatch(tx, match); err != nil {
+			return err
+		}
+		return updateAccount(tx, puuid, func(account *TrackedAccount) {
+			account.LastSyncedCreation = max(account.LastSyncedCreation, match.GameCreation)
+		})
+	})
+}
+
+// putMatch stores a match and indexes it for every participant.
+func putMatch(tx *bolt.Tx, match *lol.MatchInfo) error {
+	if err := putJSON(tx.Bucket(bucketMatches), []byte(match.MatchID), match); err != nil {
+		return err
+	}
+
+	key := matchKey(match.GameCreation, match.MatchID)
+	for _, p := range match.Participants {
+		if p.PUUID == "" {
+			continue
+		}
+		index, err := tx.Bucket(bucketPlayerMatches).CreateBucketIfNotExists([]byte(p.PUUID))
+		if err != nil {
+			return err
+		}
+		if err := index.Put(key, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HasMatch returns true if the match is stored.
+func (db *DB) HasMatch(matchID string) bool {
+	found := false
+	db.bolt.View(func(tx *bolt.Tx) error {
+		found = tx.Bucket(bucketMatches).Get([]byte(matchID)) != nil
+		return nil
+	})
+	return found
+}
+
+// Match returns a stored match.
+func (db *DB) Match(matchID string) (*lol.MatchInfo, error) {
+	var match *lol.MatchInfo
+	err := db.bolt.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(bucketMatches).Get([]byte(matchID))
+		if data == nil {
+			return fmt.Errorf("match %s not stored", matchID)
+		}
+		return json.Unmarshal(data, &match)
+	})
+	return match, err
+}
+
+// Matches returns a player's stored matches, most recent first.
+// A limit of 0 or less returns all matches from offset on.
+func (db *DB) Matches(puuid string, offset, limit int) ([]*lol.MatchInfo, error) {
+	matches := []*lol.MatchInfo{}
+	err := db.bolt.View(func(tx *bolt.Tx) error {
+		index := tx.Bucket(bucketPlayerMatches).Bucket([]byte(puuid))
+		if index == nil {
+			return nil
+		}
+
+		all := tx.Bucket(bucketMatches)
+		cursor := index.Cursor()
+		skipped := 0
+		for key, _ := cursor.Last(); key != nil; key, _ = cursor.Prev() {
+			if skipped < offset {
+				skipped++
+				continue
+			}
+			if limit > 0 && len(matches) >= limit {
+				break
+			}
+
+			data := all.Get(key[8:])
+			if data == nil {
+				continue
+			}
+			var match lol.MatchInfo
+			if err := json.Unmarshal(data, &match); err != nil {
+				return err
+			}
+			matches = append(matches, &match)
+		}
+		return nil
+	})
+	return matches, err
+}
+
+// matchKey builds an index key that sorts by game creation time.
+func matchKey(gameCreation int64, matchID string) []byte {
+	key := make([]byte, 8, 8+len(matchID))
+	binary.BigEndian.PutUint64(key, uint64(gameCreation))
+	return append(key, matchID...)
+}
+
+// putJSON stores value as JSON under key.
+func putJSON(bucket *bolt.Bucket, key []byte, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return bucket.Put(key, data)
+}
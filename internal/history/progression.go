@@ -0,0 +1,145 @@
+package history
+
+import (
+	"slices"
+	"time"
+
+	"lol-toolkit/internal/lol"
+)
+
+// Rank change events
+const (
+	RankEventPromotion = "promotion"
+	RankEventDemotion  = "demotion"
+)
+
+// tiers lists the ranked tiers from lowest to highest.
+var tiers = []string{"IRON", "BRONZE", "SILVER", "GOLD", "PLATINUM", "EMERALD", "DIAMOND", "MASTER", "GRANDMASTER", "CHALLENGER"}
+
+// divisions lists the divisions within a tier from lowest to highest.
+var divisions = []string{"IV", "III", "II", "I"}
+
+// apexTier is the first tier without divisions; apex tiers share one LP scale.
+const apexTier = "MASTER"
+
+// RankPoint is a player's rank in one queue at a point in time.
+type RankPoint struct {
+	Timestamp    int64  `json:"timestamp"` // unix milliseconds
+	Tier         string `json:"tier"`
+	Rank         string `json:"rank"`
+	LeaguePoints int    `json:"leaguePoints"`
+	Wins         int    `json:"wins"`
+	Losses       int    `json:"losses"`
+	AbsoluteLP   int    `json:"absoluteLp"` // LP counted from Iron IV 0 LP, for charting
+}
+
+// RankChange is the difference between two consecutive rank points.
+type RankChange struct {
+	Timestamp int64  `json:"timestamp"` // when the later point was taken
+	Games     int    `json:"games"`     // usually 1; more if snapshots were missed
+	Wins      int    `json:"wins"`
+	LPDelta   int    `json:"lpDelta"`
+	Event     string `json:"event,omitempty"` // "promotion", "demotion" or ""
+	FromTier  string `json:"fromTier"`
+	FromRank  string `json:"fromRank"`
+	ToTier    string `json:"toTier"`
+	ToRank    string `json:"toRank"`
+}
+
+// RankProgression is a player's rank history in one queue.
+type RankProgression struct {
+	QueueType string       `json:"queueType"`
+	Points    []RankPoint  `json:"points"`
+	Changes   []RankChange `json:"changes"`
+}
+
+// Progression returns a player's rank time series and per-game LP changes in a queue
+// (e.g. lol.QueueRankedSolo), from snapshots taken at or after since.
+func (db *DB) Progression(puuid, queueType string, since time.Time) (*RankProgression, error) {
+	snapshots, err := db.RankedSnapshots(puuid, since)
+	if err != nil {
+		return nil, err
+	}
+
+	progression := &RankProgression{QueueType: queueType, Points: []RankPoint{}, Changes: []RankChange{}}
+	for _, snapshot := range snapshots {
+		entry := findQueue(snapshot.Entries, queueType)
+		if entry == nil {
+			continue
+		}
+
+		point := RankPoint{
+			Timestamp:    snapshot.Timestamp,
+			Tier:         entry.Tier,
+			Rank:         entry.Rank,
+			LeaguePoints: entry.LeaguePoints,
+			Wins:         entry.Wins,
+			Losses:       entry.Losses,
+			AbsoluteLP:   absoluteLP(entry.Tier, entry.Rank, entry.LeaguePoints),
+		}
+
+		if n := len(progression.Points); n > 0 {
+			previous := progression.Points[n-1]
+			games := (point.Wins + point.Losses) - (previous.Wins + previous.Losses)
+			if games <= 0 && point.AbsoluteLP == previous.AbsoluteLP {
+				// No game played since the last snapshot
+				continue
+			}
+			progression.Changes = append(progression.Changes, rankChange(previous, point, games))
+		}
+		progression.Points = append(progression.Points, point)
+	}
+
+	return progression, nil
+}
+
+// rankChange describes the move from one rank point to the next.
+func rankChange(from, to RankPoint, games int) RankChange {
+	change := RankChange{
+		Timestamp: to.Timestamp,
+		Games:     games,
+		Wins:      to.Wins - from.Wins,
+		LPDelta:   to.AbsoluteLP - from.AbsoluteLP,
+		FromTier:  from.Tier,
+		FromRank:  from.Rank,
+		ToTier:    to.Tier,
+		ToRank:    to.Rank,
+	}
+
+	switch fromLevel, toLevel := rankLevel(from.Tier, from.Rank), rankLevel(to.Tier, to.Rank); {
+	case toLevel > fromLevel:
+		change.Event = RankEventPromotion
+	case toLevel < fromLevel:
+		change.Event = RankEventDemotion
+	}
+
+	return change
+}
+
+// findQueue returns the entry for a queue, or nil if the player is unranked in it.
+func findQueue(entries []*lol.RankedInfo, queueType string) *lol.RankedInfo {
+	for _, entry := range entries {
+		if entry.QueueType == queueType {
+			return entry
+		}
+	}
+	return nil
+}
+
+// rankLevel orders tier and division pairs; apex tiers have no divisions.
+func rankLevel(tier, rank string) int {
+	t := slices.Index(tiers, tier)
+	if t >= slices.Index(tiers, apexTier) {
+		return t * len(divisions)
+	}
+	return t*len(divisions) + max(slices.Index(divisions, rank), 0)
+}
+
+// absoluteLP converts a rank into LP counted from Iron IV 0 LP.
+// Apex tiers share the LP scale starting at Master 0 LP.
+func absoluteLP(tier, rank string, leaguePoints int) int {
+	if slices.Index(tiers, tier) >= slices.Index(tiers, apexTier) {
+		return rankLevel(apexTier, "")*100 + leaguePoints
+	}
+	return rankLevel(tier, rank)*100 + leaguePoints
+}
@@ -0,0 +1,94 @@
+package history
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"lol-toolkit/internal/lol"
+)
+
+// RankedSnapshot is a player's ranked entries at a point in time.
+type RankedSnapshot struct {
+	Timestamp int64             `json:"timestamp"` // unix milliseconds
+	Entries   []*lol.RankedInfo `json:"entries"`
+}
+
+// SaveRankedSnapshot stores a player's ranked entries at the given time.
+func (db *DB) SaveRankedSnapshot(puuid string, at time.Time, entries []*lol.RankedInfo) error {
+	snapshot := RankedSnapshot{Timestamp: at.UnixMilli(), Entries: entries}
+
+	return db.bolt.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.Bucket(bucketRanked).CreateBucketIfNotExists([]byte(puuid))
+		if err != nil {
+			return err
+		}
+		return putJSON(bucket, timeKey(snapshot.Timestamp), snapshot)
+	})
+}
+
+// LatestRankedSnapshot returns a player's most recent ranked snapshot, or nil if there is none.
+func (db *DB) LatestRankedSnapshot(puuid string) (*RankedSnapshot, error) {
+	var snapshot *RankedSnapshot
+	err := db.bolt.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketRanked).Bucket([]byte(puuid))
+		if bucket == nil {
+			return nil
+		}
+		if key, data := bucket.Cursor().Last(); key != nil {
+			return json.Unmarshal(data, &snapshot)
+		}
+		return nil
+	})
+	return snapshot, err
+}
+
+// RankedSnapshots returns a player's ranked snapshots taken at or after since, oldest first.
+func (db *DB) RankedSnapshots(puuid string, since time.Time) ([]RankedSnapshot, error) {
+	snapshots := []RankedSnapshot{}
+	err := db.bolt.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketRanked).Bucket([]byte(puuid))
+		if bucket == nil {
+			return nil
+		}
+
+		cursor := bucket.Cursor()
+		for key, data := cursor.Seek(timeKey(max(since.UnixMilli(), 0))); key != nil; key, data = cursor.Next() {
+			var snapshot RankedSnapshot
+			if err := json.Unmarshal(data, &snapshot); err != nil {
+				return err
+			}
+			snapshots = append(snapshots, snapshot)
+		}
+		return nil
+	})
+	return snapshots, err
+}
+
+// timeKey builds a key that sorts by timestamp.
+func timeKey(timestamp int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(timestamp))
+	return key
+}
+
+// FetchRanked gets a player's current ranked entries from the Riot API. The entries bypass
+// the response cache, so a snapshot taken right after a game is not the pre-game one.
+func FetchRanked(client *lol.Client, puuid string) ([]*lol.RankedInfo, error) {
+	summoner, err := client.GetSummonerByPUUID(puuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.RefreshRankedStats(summoner.ID)
+}
+
+// GamesPlayed returns the number of games recorded in a queue, or 0 if unranked.
+func (s *RankedSnapshot) GamesPlayed(queueType string) int {
+	if entry := findQueue(s.Entries, queueType); entry != nil {
+		return entry.Wins + entry.Losses
+	}
+	return 0
+}
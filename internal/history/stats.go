@@ -0,0 +1,116 @@
+package history
+
+import (
+	"cmp"
+	"slices"
+)
+
+// AccountStats aggregates a player's stored matches.
+type AccountStats struct {
+	Games     int              `json:"games"`
+	Wins      int              `json:"wins"`
+	WinRate   float64          `json:"winRate"` // 0-1
+	KDA       float64          `json:"kda"`
+	CSPerMin  float64          `json:"csPerMin"`
+	Champions []*ChampionStats `json:"champions"` // most played first
+}
+
+// ChampionStats aggregates a player's stored matches on one champion.
+type ChampionStats struct {
+	ChampionID   int     `json:"championId"`
+	ChampionName string  `json:"championName"`
+	Games        int     `json:"games"`
+	Wins         int     `json:"wins"`
+	WinRate      float64 `json:"winRate"`
+	KDA          float64 `json:"kda"`
+	CSPerMin     float64 `json:"csPerMin"`
+}
+
+// totals accumulates raw numbers for a stats row.
+type totals struct {
+	games, wins, kills, deaths, assists, cs, seconds int
+}
+
+// Stats computes a player's stats from stored matches, optionally limited to one queue (0 for all).
+func (db *DB) Stats(puuid string, queueID int) (*AccountStats, error) {
+	matches, err := db.Matches(puuid, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var overall totals
+	perChampion := make(map[int]*totals)
+	names := make(map[int]string)
+
+	for _, match := range matches {
+		if queueID != 0 && match.QueueID != queueID {
+			continue
+		}
+		for _, p := range match.Participants {
+			if p.PUUID != puuid || p.EarlySurrender {
+				continue
+			}
+
+			champion, ok := perChampion[p.ChampionID]
+			if !ok {
+				champion = &totals{}
+				perChampion[p.ChampionID] = champion
+				names[p.ChampionID] = p.ChampionName
+			}
+			for _, t := range []*totals{&overall, champion} {
+				t.games++
+				if p.Win {
+					t.wins++
+				}
+				t.kills += p.Kills
+				t.deaths += p.Deaths
+				t.assists += p.Assists
+				t.cs += p.CS
+				t.seconds += match.GameDuration
+			}
+		}
+	}
+
+	stats := &AccountStats{
+		Games:     overall.games,
+		Wins:      overall.wins,
+		WinRate:   overall.winRate(),
+		KDA:       overall.kda(),
+		CSPerMin:  overall.csPerMin(),
+		Champions: make([]*ChampionStats, 0, len(perChampion)),
+	}
+	for id, t := range perChampion {
+		stats.Champions = append(stats.Champions, &ChampionStats{
+			ChampionID:   id,
+			ChampionName: names[id],
+			Games:        t.games,
+			Wins:         t.wins,
+			WinRate:      t.winRate(),
+			KDA:          t.kda(),
+			CSPerMin:     t.csPerMin(),
+		})
+	}
+	slices.SortFunc(stats.Champions, func(a, b *ChampionStats) int {
+		return cmp.Or(b.Games-a.Games, a.ChampionID-b.ChampionID)
+	})
+
+	return stats, nil
+}
+
+func (t totals) winRate() float64 {
+	if t.games == 0 {
+		return 0
+	}
+	return float64(t.wins) / float64(t.games)
+}
+
+func (t totals) kda() float64 {
+	return float64(t.kills+t.assists) / float64(max(t.deaths, 1))
+}
+
+func (t totals) csPerMin() float64 {
+	if t.seconds == 0 {
+		return 0
+	}
+	return float64(t.cs) / (float64(t.seconds) / 60)
+}
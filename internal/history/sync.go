@@ -0,0 +1,116 @@
+package history
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"lol-toolkit/internal/lol"
+)
+
+// Sync limits
+const (
+	syncPageSize     = 100 // match IDs per list request (the match-v5 maximum)
+	initialSyncCount = 100 // matches backfilled for an account that has none stored
+)
+
+// SyncResult reports what a sync stored for one account.
+type SyncResult struct {
+	PUUID      string `json:"puuid"`
+	NewMatches int    `json:"newMatches"`
+	Error      string `json:"error,omitempty"`
+}
+
+// SyncAll syncs every tracked account. Failures are reported per account.
+func (db *DB) SyncAll(client *lol.Client) ([]SyncResult, error) {
+	if !db.syncing.TryLock() {
+		return nil, fmt.Errorf("sync already running")
+	}
+	defer db.syncing.Unlock()
+
+	accounts, err := db.TrackedAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]SyncResult, 0, len(accounts))
+	for _, account := range accounts {
+		result := SyncResult{PUUID: account.PUUID}
+		result.NewMatches, err = db.sync(client, account)
+		if err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// sync fetches the matches played since the most recent one a sync stored, plus a ranked snapshot.
+// Matches are stored oldest first, so an interrupted sync resumes where it stopped.
+func (db *DB) sync(client *lol.Client, account TrackedAccount) (int, error) {
+	puuid := account.PUUID
+	ids, err := db.newMatchIDs(client, puuid, account.LastSyncedCreation)
+	if err != nil {
+		return 0, err
+	}
+
+	stored := 0
+	for _, id := range slices.Backward(ids) {
+		match, err := client.GetMatch(id)
+		if err != nil {
+			return stored, err
+		}
+		if err := db.saveSyncedMatch(puuid, match); err != nil {
+			return stored, err
+		}
+		stored++
+	}
+
+	entries, err := FetchRanked(client, puuid)
+	if err != nil {
+		return stored, err
+	}
+	if err := db.SaveRankedSnapshot(puuid, time.Now(), entries); err != nil {
+		return stored, err
+	}
+
+	return stored, db.markSynced(puuid, time.Now())
+}
+
+// newMatchIDs lists the IDs of matches that are not stored yet, most recent first.
+// latest is the account's sync watermark, 0 for an account that was never synced.
+// Paging stops at the first page whose matches are all stored: the older pages were
+// stored before it, e.g. by a sync that ran before the watermark was kept.
+func (db *DB) newMatchIDs(client *lol.Client, puuid string, latest int64) ([]string, error) {
+	filters := lol.MatchFilters{Count: syncPageSize}
+	if latest > 0 {
+		filters.StartTime = latest / 1000
+	}
+
+	var ids []string
+	for {
+		page, err := client.GetMatchIDs(puuid, filters)
+		if err != nil {
+			return nil, err
+		}
+
+		known := 0
+		for _, id := range page {
+			if db.HasMatch(id) {
+				known++
+			} else {
+				ids = append(ids, id)
+			}
+		}
+
+		if len(page) < syncPageSize || known == len(page) || (latest == 0 && len(ids) >= initialSyncCount) {
+			break
+		}
+		filters.Start += syncPageSize
+	}
+
+	if latest == 0 && len(ids) > initialSyncCount {
+		ids = ids[:initialSyncCount]
+	}
+	return ids, nil
+}
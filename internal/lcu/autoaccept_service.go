@@ -58,39 +58,36 @@ const (
 	ClientStateUnknown     ClientState = "Unknown"
 )
 
-// Polling intervals for different client states
+// LCU endpoints watched by the auto-accept service.
+const (
+	gameflowPhaseURI = "/lol-gameflow/v1/gameflow-phase"
+	readyCheckURI    = "/lol-matchmaking/v1/ready-check"
+)
+
+// busCheckInterval is how long the service polls before checking the event bus again.
+const busCheckInterval = 10 * time.Second
+
+// Polling intervals for different client states, used only while the WebSocket is down
 const (
 	pollIntervalVeryFast = 200 * time.Millisecond // Match found - need fast acceptance
 	pollIntervalFast     = 500 * time.Millisecond // In queue - waiting for match
 	pollIntervalSlow     = 3 * time.Second        // Idle/not relevant - minimal overhead
 )
 
-// buildLCUHeaders creates HTTP headers for LCU API requests.
-func buildLCUHeaders() map[string]string {
-	_, token, _ := getConnectionInfo()
-	headers := make(map[string]string)
-	if token != "" {
-		headers["Authorization"] = fmt.Sprintf("Basic %s", base64Encode(fmt.Sprintf("riot:%s", token)))
-	}
-	headers["Accept"] = "application/json"
-	return headers
-}
-
 // AcceptMatch accepts a ready check match.
 func (c *Client) AcceptMatch() error {
-	headers := buildLCUHeaders()
+	headers := c.buildHeaders()
 
 	// Wrap with LoggedCall - use interface{} as result type since POST returns empty body
 	_, err := LoggedCall("POST", "/lol-matchmaking/v1/ready-check/accept", http.StatusOK, headers, func() (interface{}, error) {
-		// Use lcu-gopher's client Post method directly
-		resp, err := c.client.Post("/lol-matchmaking/v1/ready-check/accept", nil)
+		resp, err := c.do("POST", "/lol-matchmaking/v1/ready-check/accept", nil)
 		if err != nil {
 			return nil, err
 		}
 		defer resp.Body.Close()
 
 		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-			return nil, fmt.Errorf("failed to accept match: status %d", resp.StatusCode)
+			return nil, newAPIError("/lol-matchmaking/v1/ready-check/accept", resp)
 		}
 
 		return nil, nil
@@ -100,18 +97,17 @@ func (c *Client) AcceptMatch() error {
 
 // GetReadyCheck gets the current ready check status.
 func (c *Client) GetReadyCheck() (*ReadyCheckResource, error) {
-	headers := buildLCUHeaders()
+	headers := c.buildHeaders()
 
 	return LoggedCall("GET", "/lol-matchmaking/v1/ready-check", http.StatusOK, headers, func() (*ReadyCheckResource, error) {
-		// Use lcu-gopher's client Get method directly
-		resp, err := c.client.Get("/lol-matchmaking/v1/ready-check")
+		resp, err := c.do("GET", "/lol-matchmaking/v1/ready-check", nil)
 		if err != nil {
 			return nil, err
 		}
 		defer resp.Body.Close()
 
 		if resp.StatusCode != http.StatusOK {
-			return nil, fmt.Errorf("failed to get ready check: status %d", resp.StatusCode)
+			return nil, newAPIError("/lol-matchmaking/v1/ready-check", resp)
 		}
 
 		// Read body to check for "None" response before decoding
@@ -123,7 +119,7 @@ func (c *Client) GetReadyCheck() (*ReadyCheckResource, error) {
 		// Check for "None" response - indicates ready check not available
 		responseStr := strings.TrimSpace(string(data))
 		if responseStr == "" || responseStr == `"None"` || responseStr == "None" || responseStr == "null" {
-			return nil, fmt.Errorf("lcu api error: 404 Not Found - ready check not available")
+			return nil, &APIError{Status: http.StatusNotFound, Body: "ready check not available", Endpoint: "/lol-matchmaking/v1/ready-check"}
 		}
 
 		// Decode JSON response
@@ -138,18 +134,17 @@ func (c *Client) GetReadyCheck() (*ReadyCheckResource, error) {
 
 // GetGameflowPhase gets the current gameflow phase.
 func (c *Client) GetGameflowPhase() (GameflowPhase, error) {
-	headers := buildLCUHeaders()
+	headers := c.buildHeaders()
 
 	return LoggedCall("GET", "/lol-gameflow/v1/gameflow-phase", http.StatusOK, headers, func() (GameflowPhase, error) {
-		// Use lcu-gopher's client directly - same pattern as their GetCurrentSummoner
-		resp, err := c.client.Get("/lol-gameflow/v1/gameflow-phase")
+		resp, err := c.do("GET", "/lol-gameflow/v1/gameflow-phase", nil)
 		if err != nil {
 			return "", err
 		}
 		defer resp.Body.Close()
 
 		if resp.StatusCode != http.StatusOK {
-			return "", fmt.Errorf("failed to get gameflow phase: status %d", resp.StatusCode)
+			return "", newAPIError("/lol-gameflow/v1/gameflow-phase", resp)
 		}
 
 		var phase string
@@ -170,43 +165,50 @@ func (c *Client) GetClientState() (ClientState, error) {
 		return ClientStateUnknown, err
 	}
 
+	return clientStateFromPhase(phase), nil
+}
+
+// clientStateFromPhase maps a gameflow phase to a client state.
+func clientStateFromPhase(phase GameflowPhase) ClientState {
 	switch phase {
 	case GameflowPhaseNone, GameflowPhaseLobby:
-		return ClientStateNotInQueue, nil
+		return ClientStateNotInQueue
 	case GameflowPhaseMatchmaking:
-		return ClientStateInQueue, nil
+		return ClientStateInQueue
 	case GameflowPhaseReadyCheck:
-		return ClientStateMatchFound, nil
+		return ClientStateMatchFound
 	case GameflowPhaseChampSelect:
-		return ClientStateChampSelect, nil
+		return ClientStateChampSelect
 	case GameflowPhaseInProgress, GameflowPhaseReconnect:
-		return ClientStateInGame, nil
+		return ClientStateInGame
 	case GameflowPhaseWaitingForStats, GameflowPhasePreEndOfGame:
 		// Post-game states - consider as not in queue
-		return ClientStateNotInQueue, nil
+		return ClientStateNotInQueue
 	default:
-		return ClientStateUnknown, nil
+		return ClientStateUnknown
 	}
 }
 
 // AutoAcceptService manages auto-accept functionality.
 type AutoAcceptService struct {
 	client          *Client
+	events          *EventBus
 	enabled         bool
 	autoAccept      bool
 	consecutive404s int // Track consecutive 404 errors to reduce polling
 	mu              sync.Mutex
 	stop            chan struct{}
 	wg              sync.WaitGroup
-	onStopped       func() // Callback when service stops due to connection error
+	onStopped       func() // Callback when auto-accept turns itself off
 	// Client state tracking
 	lastClientState ClientState // Last detected client state
 }
 
-// NewAutoAcceptService creates a new auto-accept service.
-func NewAutoAcceptService(client *Client) *AutoAcceptService {
+// NewAutoAcceptService creates a new auto-accept service that listens on the given event bus.
+func NewAutoAcceptService(client *Client, events *EventBus) *AutoAcceptService {
 	return &AutoAcceptService{
 		client:     client,
+		events:     events,
 		enabled:    false,
 		autoAccept: true,
 		stop:       make(chan struct{}),
@@ -254,7 +256,23 @@ func (s *AutoAcceptService) SetAutoAccept(enabled bool) {
 	s.autoAccept = enabled
 }
 
-// SetOnStopped sets a callback to be called when the service stops due to connection error.
+// SetClient swaps in a new client, e.g. after the League client restarted.
+// The service keeps running and resumes with the new connection.
+func (s *AutoAcceptService) SetClient(client *Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.client = client
+	s.lastClientState = ""
+}
+
+// getClient returns the current client.
+func (s *AutoAcceptService) getClient() *Client {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.client
+}
+
+// SetOnStopped sets a callback to be called when auto-accept turns itself off once a game starts.
 func (s *AutoAcceptService) SetOnStopped(callback func()) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -262,9 +280,58 @@ func (s *AutoAcceptService) SetOnStopped(callback func()) {
 }
 
 // run is the main loop for the auto-accept service.
+// It reacts to event bus events and falls back to polling while the bus is down.
 func (s *AutoAcceptService) run() {
 	defer s.wg.Done()
 
+	unsubscribeGameflow := SubscribeJSON(s.events, gameflowPhaseURI, s.handleGameflowEvent, EventTypeCreate, EventTypeUpdate)
+	defer unsubscribeGameflow()
+	unsubscribeReadyCheck := SubscribeJSON(s.events, readyCheckURI, s.handleReadyCheckEvent, EventTypeCreate, EventTypeUpdate)
+	defer unsubscribeReadyCheck()
+
+	for {
+		if s.events.Connected() {
+			// Catch up on anything that happened before the subscription
+			s.checkAndProcess()
+
+			select {
+			case <-s.stop:
+				return
+			case <-s.events.Done():
+			}
+			continue
+		}
+
+		// Event bus is down - poll until it is back
+		if !s.poll(time.After(busCheckInterval)) {
+			return
+		}
+	}
+}
+
+// handleGameflowEvent updates the client state from a gameflow phase event.
+func (s *AutoAcceptService) handleGameflowEvent(_ *Event, phase GameflowPhase) {
+	if !s.shouldProcess() {
+		return
+	}
+
+	s.updateClientState(clientStateFromPhase(phase))
+}
+
+// handleReadyCheckEvent accepts the match as soon as a ready check event arrives.
+func (s *AutoAcceptService) handleReadyCheckEvent(_ *Event, readyCheck ReadyCheckResource) {
+	if !s.shouldProcess() {
+		return
+	}
+
+	if readyCheck.State == ReadyCheckInProgress && s.shouldAcceptReadyCheck(&readyCheck) {
+		s.getClient().AcceptMatch()
+	}
+}
+
+// poll runs the polling loop until the deadline fires.
+// It returns false if the service was stopped in the meantime.
+func (s *AutoAcceptService) poll(until <-chan time.Time) bool {
 	ticker := time.NewTicker(pollIntervalFast)
 	defer ticker.Stop()
 
@@ -273,15 +340,16 @@ func (s *AutoAcceptService) run() {
 	for {
 		select {
 		case <-s.stop:
-			return
+			return false
+		case <-until:
+			return true
 		case <-ticker.C:
 			s.checkAndProcess()
 
 			// Adjust polling interval based on client state
 			newInterval := s.getPollingInterval()
 			if newInterval != lastInterval {
-				ticker.Stop()
-				ticker = time.NewTicker(newInterval)
+				ticker.Reset(newInterval)
 				lastInterval = newInterval
 			}
 		}
@@ -314,7 +382,7 @@ func (s *AutoAcceptService) checkAndProcess() {
 		return
 	}
 
-	state, err := s.client.GetClientState()
+	state, err := s.getClient().GetClientState()
 	if err != nil {
 		s.incrementConsecutive404s()
 		return
@@ -378,7 +446,7 @@ func (s *AutoAcceptService) resetConsecutive404s() {
 
 // checkReadyCheck checks for ready check and accepts matches.
 func (s *AutoAcceptService) checkReadyCheck() {
-	readyCheck, err := s.client.GetReadyCheck()
+	readyCheck, err := s.getClient().GetReadyCheck()
 	if err != nil {
 		s.handleReadyCheckError(err)
 		return
@@ -392,7 +460,7 @@ func (s *AutoAcceptService) checkReadyCheck() {
 
 	// Accept if ready check is in progress and we haven't responded yet
 	if readyCheck.State == ReadyCheckInProgress && s.shouldAcceptReadyCheck(readyCheck) {
-		s.client.AcceptMatch()
+		s.getClient().AcceptMatch()
 	}
 }
 
@@ -403,7 +471,7 @@ func (s *AutoAcceptService) shouldAcceptReadyCheck(readyCheck *ReadyCheckResourc
 
 // handleReadyCheckError handles errors from GetReadyCheck.
 func (s *AutoAcceptService) handleReadyCheckError(err error) {
-	if is404Error(err) {
+	if IsNotFound(err) {
 		s.incrementConsecutive404s()
 		return
 	}
@@ -413,37 +481,10 @@ func (s *AutoAcceptService) handleReadyCheckError(err error) {
 	}
 }
 
-// handleConnectionRefused handles connection refused errors by stopping the service.
+// handleConnectionRefused forgets the client state while the League client is unreachable.
+// Polling is paused by the connection status until a new client is set.
 func (s *AutoAcceptService) handleConnectionRefused() {
 	s.mu.Lock()
-	wasEnabled := s.enabled
-	s.enabled = false
-	s.autoAccept = false
-	stopChan := s.stop
-	onStopped := s.onStopped
-	s.mu.Unlock()
-
-	if wasEnabled {
-		select {
-		case <-stopChan:
-			// Channel already closed
-		default:
-			close(stopChan)
-		}
-
-		if onStopped != nil {
-			onStopped()
-		}
-	}
-}
-
-// is404Error checks if an error is a 404 (expected when not in queue/champ select).
-func is404Error(err error) bool {
-	if err == nil {
-		return false
-	}
-	errStr := strings.ToLower(err.Error())
-	return strings.Contains(errStr, "404") ||
-		strings.Contains(errStr, "not attached") ||
-		strings.Contains(errStr, "no active delegate")
+	defer s.mu.Unlock()
+	s.lastClientState = ""
 }
@@ -0,0 +1,233 @@
+package lcu
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ChampSelectActionType represents the type of a champ select action.
+type ChampSelectActionType string
+
+const (
+	ChampSelectActionPick ChampSelectActionType = "pick"
+	ChampSelectActionBan  ChampSelectActionType = "ban"
+)
+
+// ChampSelectPhaseFinalization is the timer phase after every pick, when the champions are final.
+const ChampSelectPhaseFinalization = "FINALIZATION"
+
+// ChampSelectSession represents the current champ select session.
+type ChampSelectSession struct {
+	GameID            int64                 `json:"gameId"`
+	LocalPlayerCellID int                   `json:"localPlayerCellId"`
+	MyTeam            []ChampSelectPlayer   `json:"myTeam"`
+	TheirTeam         []ChampSelectPlayer   `json:"theirTeam"`
+	Actions           [][]ChampSelectAction `json:"actions"`
+	Bans              ChampSelectBans       `json:"bans"`
+	Timer             ChampSelectTimer      `json:"timer"`
+	IsSpectating      bool                  `json:"isSpectating"`
+}
+
+// ChampSelectPlayer represents a player in champ select.
+type ChampSelectPlayer struct {
+	CellID             int    `json:"cellId"`
+	AssignedPosition   string `json:"assignedPosition"`
+	ChampionID         int    `json:"championId"`
+	ChampionPickIntent int    `json:"championPickIntent"`
+	SummonerID         int64  `json:"summonerId"`
+	PUUID              string `json:"puuid"`
+	Spell1ID           int64  `json:"spell1Id"`
+	Spell2ID           int64  `json:"spell2Id"`
+	Team               int    `json:"team"`
+}
+
+// ChampSelectAction represents a single pick or ban action.
+type ChampSelectAction struct {
+	ID           int                   `json:"id"`
+	ActorCellID  int                   `json:"actorCellId"`
+	ChampionID   int                   `json:"championId"`
+	Completed    bool                  `json:"completed"`
+	IsAllyAction bool                  `json:"isAllyAction"`
+	IsInProgress bool                  `json:"isInProgress"`
+	Type         ChampSelectActionType `json:"type"`
+}
+
+// ChampSelectBans lists the bans of both teams.
+type ChampSelectBans struct {
+	MyTeamBans    []int `json:"myTeamBans"`
+	TheirTeamBans []int `json:"theirTeamBans"`
+}
+
+// ChampSelectTimer represents the champ select phase timer.
+type ChampSelectTimer struct {
+	Phase                   string `json:"phase"`
+	AdjustedTimeLeftInPhase int64  `json:"adjustedTimeLeftInPhase"` // milliseconds
+}
+
+// LocalPlayer returns the local player's entry in the session, or nil if not found.
+func (s *ChampSelectSession) LocalPlayer() *ChampSelectPlayer {
+	for i := range s.MyTeam {
+		if s.MyTeam[i].CellID == s.LocalPlayerCellID {
+			return &s.MyTeam[i]
+		}
+	}
+	return nil
+}
+
+// UnavailableChampions returns the champions that are already banned or picked by anyone.
+func (s *ChampSelectSession) UnavailableChampions() map[int]bool {
+	unavailable := make(map[int]bool)
+
+	for _, id := range s.Bans.MyTeamBans {
+		unavailable[id] = true
+	}
+	for _, id := range s.Bans.TheirTeamBans {
+		unavailable[id] = true
+	}
+
+	for _, group := range s.Actions {
+		for _, action := range group {
+			if action.Completed && action.ChampionID != 0 {
+				unavailable[action.ChampionID] = true
+			}
+		}
+	}
+
+	for _, player := range append(append([]ChampSelectPlayer{}, s.MyTeam...), s.TheirTeam...) {
+		if player.CellID != s.LocalPlayerCellID && player.ChampionID != 0 {
+			unavailable[player.ChampionID] = true
+		}
+	}
+
+	delete(unavailable, 0)
+	return unavailable
+}
+
+// ActiveActions returns the local player's actions that are in progress and not completed.
+func (s *ChampSelectSession) ActiveActions() []ChampSelectAction {
+	var active []ChampSelectAction
+	for _, group := range s.Actions {
+		for _, action := range group {
+			if action.ActorCellID == s.LocalPlayerCellID && action.IsInProgress && !action.Completed {
+				active = append(active, action)
+			}
+		}
+	}
+	return active
+}
+
+// LockedInChampion returns the champion the local player has locked in, or 0 if none.
+// In queues without pick actions, such as ARAM, the champion is assigned rather than picked,
+// so the local player's champion counts as locked in. In FINALIZATION it is final either way.
+func (s *ChampSelectSession) LockedInChampion() int {
+	hasPick := false
+	for _, group := range s.Actions {
+		for _, action := range group {
+			if action.ActorCellID != s.LocalPlayerCellID || action.Type != ChampSelectActionPick {
+				continue
+			}
+			if action.Completed {
+				return action.ChampionID
+			}
+			hasPick = true
+		}
+	}
+
+	if !hasPick || s.Timer.Phase == ChampSelectPhaseFinalization {
+		if player := s.LocalPlayer(); player != nil {
+			return player.ChampionID
+		}
+	}
+	return 0
+}
+
+// GetChampSelectSession gets the current champ select session.
+func (c *Client) GetChampSelectSession() (*ChampSelectSession, error) {
+	headers := c.buildHeaders()
+
+	return LoggedCall("GET", "/lol-champ-select/v1/session", http.StatusOK, headers, func() (*ChampSelectSession, error) {
+		resp, err := c.do("GET", "/lol-champ-select/v1/session", nil)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, newAPIError("/lol-champ-select/v1/session", resp)
+		}
+
+		var session ChampSelectSession
+		if err := json.NewDecoder(resp.Body).Decode(&session); err != nil {
+			return nil, fmt.Errorf("failed to decode champ select session: %w", err)
+		}
+
+		return &session, nil
+	})
+}
+
+// GetPickableChampionIDs gets the champions the local player may pick.
+func (c *Client) GetPickableChampionIDs() ([]int, error) {
+	return c.getChampionIDs("/lol-champ-select/v1/pickable-champion-ids")
+}
+
+// GetBannableChampionIDs gets the champions the local player may ban.
+func (c *Client) GetBannableChampionIDs() ([]int, error) {
+	return c.getChampionIDs("/lol-champ-select/v1/bannable-champion-ids")
+}
+
+// getChampionIDs gets a list of champion IDs from a champ select endpoint.
+func (c *Client) getChampionIDs(endpoint string) ([]int, error) {
+	data, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return nil, fmt.Errorf("failed to decode champion ids: %w", err)
+	}
+	return ids, nil
+}
+
+// HoverChampion selects a champion for an action without locking it in.
+func (c *Client) HoverChampion(actionID, championID int) error {
+	return c.patchAction(actionID, map[string]interface{}{
+		"championId": championID,
+	})
+}
+
+// CompleteAction locks in (or bans) the champion for an action.
+func (c *Client) CompleteAction(actionID, championID int) error {
+	return c.patchAction(actionID, map[string]interface{}{
+		"championId": championID,
+		"completed":  true,
+	})
+}
+
+// SetSummonerSpells sets the local player's summoner spells (spell1 on D, spell2 on F).
+func (c *Client) SetSummonerSpells(spell1ID, spell2ID int) error {
+	body, err := json.Marshal(map[string]int{
+		"spell1Id": spell1ID,
+		"spell2Id": spell2ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Request("PATCH", "/lol-champ-select/v1/session/my-selection", bytes.NewReader(body))
+	return err
+}
+
+// patchAction patches a champ select action.
+func (c *Client) patchAction(actionID int, fields map[string]interface{}) error {
+	body, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("/lol-champ-select/v1/session/actions/%d", actionID)
+	_, err = c.Request("PATCH", endpoint, bytes.NewReader(body))
+	return err
+}
@@ -0,0 +1,337 @@
+package lcu
+
+import (
+	"sync"
+	"time"
+)
+
+// champSelectSessionURI is the LCU endpoint for the champ select session.
+const champSelectSessionURI = "/lol-champ-select/v1/session"
+
+// lockInSafetyMargin is how long before the phase timer runs out a pending lock-in is forced.
+const lockInSafetyMargin = 2 * time.Second
+
+// anyPosition is the priority list key used when no role-specific list applies
+// (blind pick, ARAM, or an empty list for the assigned position).
+const anyPosition = ""
+
+// ChampSelectSettings configures auto-pick and auto-ban.
+type ChampSelectSettings struct {
+	AutoPick bool
+	AutoBan  bool
+	// PickPriority and BanPriority map an assigned position ("top", "jungle",
+	// "middle", "bottom", "utility") to champion IDs in priority order.
+	// The "" key is used as a fallback for any position.
+	PickPriority map[string][]int
+	BanPriority  map[string][]int
+	// LockInDelay is how long a pick or ban stays hovered before it is locked in.
+	LockInDelay time.Duration
+}
+
+// ChampSelectService hovers, locks in and bans champions from per-role priority lists.
+// Sessions are processed one at a time on the service's own goroutine, never on the event bus.
+type ChampSelectService struct {
+	client   *Client
+	events   *EventBus
+	settings ChampSelectSettings
+	enabled  bool
+	pending  map[int]bool // action IDs with a scheduled lock-in
+	timers   map[int]*time.Timer
+	updates  chan *ChampSelectSession // latest session not processed yet; nil when champ select ended
+	mu       sync.Mutex
+	stop     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewChampSelectService creates a new champ select service that listens on the given event bus.
+func NewChampSelectService(client *Client, events *EventBus) *ChampSelectService {
+	return &ChampSelectService{
+		client:  client,
+		events:  events,
+		pending: make(map[int]bool),
+		timers:  make(map[int]*time.Timer),
+		updates: make(chan *ChampSelectSession, 1),
+		stop:    make(chan struct{}),
+	}
+}
+
+// Start starts the champ select service.
+func (s *ChampSelectService) Start() {
+	s.mu.Lock()
+	if s.enabled {
+		s.mu.Unlock()
+		return
+	}
+	s.enabled = true
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go s.run()
+}
+
+// Stop stops the champ select service and cancels pending lock-ins.
+func (s *ChampSelectService) Stop() {
+	s.mu.Lock()
+	if !s.enabled {
+		s.mu.Unlock()
+		return
+	}
+	s.enabled = false
+	stopChan := s.stop
+	s.mu.Unlock()
+
+	close(stopChan)
+	s.wg.Wait()
+
+	s.mu.Lock()
+	s.resetPending()
+	s.stop = make(chan struct{})
+	s.mu.Unlock()
+}
+
+// SetSettings replaces the auto-pick and auto-ban settings.
+func (s *ChampSelectService) SetSettings(settings ChampSelectSettings) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settings = settings
+}
+
+// SetClient swaps in a new client, e.g. after the League client restarted.
+func (s *ChampSelectService) SetClient(client *Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.client = client
+	s.resetPending()
+}
+
+// run processes session updates until the service is stopped.
+func (s *ChampSelectService) run() {
+	defer s.wg.Done()
+
+	// drop an update left over from before a restart
+	select {
+	case <-s.updates:
+	default:
+	}
+
+	unsubscribe := SubscribeJSON(s.events, champSelectSessionURI, s.handleSessionEvent)
+	defer unsubscribe()
+
+	// Act on a session that was already running when the service started
+	if session, err := s.getClient().GetChampSelectSession(); err == nil {
+		s.process(session)
+	}
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case session := <-s.updates:
+			if session == nil {
+				// Champ select ended or was dodged
+				s.mu.Lock()
+				s.resetPending()
+				s.mu.Unlock()
+				continue
+			}
+			s.process(session)
+		}
+	}
+}
+
+// handleSessionEvent queues a champ select session update for run.
+func (s *ChampSelectService) handleSessionEvent(event *Event, session ChampSelectSession) {
+	if event.EventType == EventTypeDelete {
+		s.offer(nil)
+		return
+	}
+	s.offer(&session)
+}
+
+// offer queues the latest session for run, replacing one it has not processed yet.
+// Only the bus goroutine of the subscription sends, so the loop ends after one retry at most.
+func (s *ChampSelectService) offer(session *ChampSelectSession) {
+	for {
+		select {
+		case s.updates <- session:
+			return
+		default:
+		}
+		select {
+		case <-s.updates:
+		default:
+		}
+	}
+}
+
+// process hovers a champion for each active local action and schedules its lock-in.
+func (s *ChampSelectService) process(session *ChampSelectSession) {
+	s.mu.Lock()
+	enabled := s.enabled
+	settings := s.settings
+	s.mu.Unlock()
+
+	if !enabled || session.IsSpectating {
+		return
+	}
+
+	for _, action := range session.ActiveActions() {
+		if s.isPending(action.ID) {
+			continue
+		}
+
+		var priority map[string][]int
+		switch {
+		case action.Type == ChampSelectActionPick && settings.AutoPick:
+			priority = settings.PickPriority
+		case action.Type == ChampSelectActionBan && settings.AutoBan:
+			priority = settings.BanPriority
+		default:
+			continue
+		}
+
+		championID := s.chooseChampion(session, action.Type, priority)
+		if championID == 0 {
+			continue
+		}
+
+		if err := s.getClient().HoverChampion(action.ID, championID); err != nil {
+			continue
+		}
+
+		s.scheduleLockIn(action.ID, championID, lockInDelay(settings.LockInDelay, session.Timer))
+	}
+}
+
+// chooseChampion returns the first available champion from the priority list for the
+// local player's position, or 0 if none is available.
+func (s *ChampSelectService) chooseChampion(session *ChampSelectSession, actionType ChampSelectActionType, priority map[string][]int) int {
+	position := anyPosition
+	if player := session.LocalPlayer(); player != nil {
+		position = player.AssignedPosition
+	}
+
+	candidates := priority[position]
+	if len(candidates) == 0 {
+		candidates = priority[anyPosition]
+	}
+	if len(candidates) == 0 {
+		return 0
+	}
+
+	allowed := s.allowedChampions(actionType)
+	unavailable := session.UnavailableChampions()
+
+	for _, championID := range candidates {
+		if unavailable[championID] {
+			continue
+		}
+		if allowed != nil && !allowed[championID] {
+			continue
+		}
+		return championID
+	}
+	return 0
+}
+
+// allowedChampions returns the champions the local player may pick or ban,
+// or nil if the list could not be fetched.
+func (s *ChampSelectService) allowedChampions(actionType ChampSelectActionType) map[int]bool {
+	fetch := s.getClient().GetPickableChampionIDs
+	if actionType == ChampSelectActionBan {
+		fetch = s.getClient().GetBannableChampionIDs
+	}
+
+	ids, err := fetch()
+	if err != nil {
+		return nil
+	}
+
+	allowed := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		allowed[id] = true
+	}
+	return allowed
+}
+
+// scheduleLockIn completes the action after the delay unless the service stops first.
+func (s *ChampSelectService) scheduleLockIn(actionID, championID int, delay time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if timer := s.timers[actionID]; timer != nil {
+		timer.Stop()
+	}
+	s.pending[actionID] = true
+	s.timers[actionID] = time.AfterFunc(delay, func() {
+		s.lockIn(actionID, championID)
+	})
+}
+
+// lockIn completes a pending action if the champion is still available.
+// Otherwise the action is released so the next session update picks again.
+func (s *ChampSelectService) lockIn(actionID, championID int) {
+	s.mu.Lock()
+	enabled := s.enabled
+	delete(s.timers, actionID)
+	s.mu.Unlock()
+
+	if !enabled {
+		return
+	}
+
+	client := s.getClient()
+	session, err := client.GetChampSelectSession()
+	if err == nil && !session.UnavailableChampions()[championID] && hasActiveAction(session, actionID) {
+		if client.CompleteAction(actionID, championID) == nil {
+			return
+		}
+	}
+
+	s.mu.Lock()
+	delete(s.pending, actionID)
+	s.mu.Unlock()
+}
+
+// hasActiveAction returns true if the action is still in progress for the local player.
+func hasActiveAction(session *ChampSelectSession, actionID int) bool {
+	for _, action := range session.ActiveActions() {
+		if action.ID == actionID {
+			return true
+		}
+	}
+	return false
+}
+
+// lockInDelay returns the configured delay, shortened so the lock-in happens before the timer expires.
+func lockInDelay(delay time.Duration, timer ChampSelectTimer) time.Duration {
+	if timer.AdjustedTimeLeftInPhase <= 0 {
+		return delay
+	}
+
+	remaining := time.Duration(timer.AdjustedTimeLeftInPhase)*time.Millisecond - lockInSafetyMargin
+	return max(min(delay, remaining), 0)
+}
+
+// isPending returns true if a lock-in is already scheduled for the action.
+func (s *ChampSelectService) isPending(actionID int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pending[actionID]
+}
+
+// resetPending cancels scheduled lock-ins. The caller must hold s.mu.
+func (s *ChampSelectService) resetPending() {
+	for _, timer := range s.timers {
+		timer.Stop()
+	}
+	s.timers = make(map[int]*time.Timer)
+	s.pending = make(map[int]bool)
+}
+
+// getClient returns the current client.
+func (s *ChampSelectService) getClient() *Client {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.client
+}
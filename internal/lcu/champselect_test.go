@@ -0,0 +1,62 @@
+package lcu
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+func loadSession(t *testing.T, name string) *ChampSelectSession {
+	t.Helper()
+	data, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var session ChampSelectSession
+	if err := json.Unmarshal(data, &session); err != nil {
+		t.Fatal(err)
+	}
+	return &session
+}
+
+func TestLockedInChampionARAM(t *testing.T) {
+	session := loadSession(t, "champselect_aram.json")
+
+	// ARAM has no pick actions: the assigned champion counts from the start
+	if got := session.LockedInChampion(); got != 22 {
+		t.Fatalf("LockedInChampion() = %d, want 22", got)
+	}
+
+	// a bench swap changes it
+	session.LocalPlayer().ChampionID = 51
+	if got := session.LockedInChampion(); got != 51 {
+		t.Fatalf("after bench swap LockedInChampion() = %d, want 51", got)
+	}
+}
+
+func TestLockedInChampionDraft(t *testing.T) {
+	session := &ChampSelectSession{
+		LocalPlayerCellID: 1,
+		MyTeam:            []ChampSelectPlayer{{CellID: 1, ChampionID: 103}},
+		Actions: [][]ChampSelectAction{{
+			{ID: 7, ActorCellID: 1, ChampionID: 103, Type: ChampSelectActionPick, IsInProgress: true},
+		}},
+		Timer: ChampSelectTimer{Phase: "BAN_PICK"},
+	}
+
+	// hovered, not locked in
+	if got := session.LockedInChampion(); got != 0 {
+		t.Fatalf("hovered LockedInChampion() = %d, want 0", got)
+	}
+
+	session.Timer.Phase = ChampSelectPhaseFinalization
+	if got := session.LockedInChampion(); got != 103 {
+		t.Fatalf("in FINALIZATION LockedInChampion() = %d, want 103", got)
+	}
+
+	session.Timer.Phase = "BAN_PICK"
+	session.Actions[0][0].Completed = true
+	if got := session.LockedInChampion(); got != 103 {
+		t.Fatalf("locked in LockedInChampion() = %d, want 103", got)
+	}
+}
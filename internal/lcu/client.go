@@ -1,36 +1,37 @@
 package lcu
 
 import (
+	"bytes"
+	"crypto/tls"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"os/exec"
-	"regexp"
 	"sync"
-	"syscall"
 	"time"
 
-	lcuclient "github.com/its-haze/lcu-gopher"
+	"lol-toolkit/internal/retry"
 )
 
 // Connection cache settings.
 const cacheTTL = 30 * time.Second
 
+// requestTimeout bounds every HTTP request to the LCU API.
+const requestTimeout = 5 * time.Second
+
+// defaultHost is the address of a League client running on this machine.
+const defaultHost = "127.0.0.1"
+
 var (
-	cache      connectionCache
-	cacheMutex sync.RWMutex
+	defaultDiscoverer = NewCachedDiscoverer(ChainDiscoverer{ProcessDiscoverer{}, LockfileDiscoverer{}}, cacheTTL)
+	discovererMutex   sync.RWMutex
 )
 
-type connectionCache struct {
-	port    string
-	token   string
-	updated time.Time
-}
-
-// Client is a thin wrapper around the lcu-gopher client.
+// Client is a thin HTTP client for the LCU API.
 type Client struct {
-	client *lcuclient.Client
+	httpClient *http.Client
+	info       ConnectionInfo
 }
 
 // CurrentSummoner represents the currently logged in summoner (DTO exposed to the frontend).
@@ -61,160 +62,157 @@ type RerollPoints struct {
 }
 
 // ConnectionInfo holds connection details for external use.
+// An empty Host means the client runs on this machine.
 type ConnectionInfo struct {
+	Host      string `json:"host,omitempty"`
 	Port      string `json:"port"`
 	AuthToken string `json:"authToken"`
 }
 
-// NewClient creates a new LCU client using lcu-gopher.
-func NewClient() (*Client, error) {
-	config := lcuclient.DefaultConfig()
-	config.AwaitConnection = false
-	config.Timeout = 5 * time.Second
-
-	client, err := lcuclient.NewClient(config)
+// NewClient creates a new LCU client using the connection info from discoverer.
+func NewClient(discoverer ConnectionDiscoverer) (*Client, error) {
+	info, err := discoverer.Discover()
 	if err != nil {
-		return nil, fmt.Errorf("league client not running: %w", err)
+		return nil, err
 	}
 
-	// Connect to the client
-	if err := client.Connect(); err != nil {
+	client := &Client{
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: true, // LCU uses a self-signed certificate
+				},
+			},
+		},
+		info: *info,
+	}
+
+	// Verify the client is actually reachable
+	if err := client.testConnection(); err != nil {
 		return nil, fmt.Errorf("failed to connect: %w", err)
 	}
 
-	return &Client{client: client}, nil
+	return client, nil
 }
 
 // GetCurrentSummoner returns the currently logged in summoner.
 func (c *Client) GetCurrentSummoner() (*CurrentSummoner, error) {
-	headers := buildClientHeaders()
+	headers := c.buildHeaders()
 
 	return LoggedCall("GET", "/lol-summoner/v1/current-summoner", http.StatusOK, headers, func() (*CurrentSummoner, error) {
-		lcuSummoner, err := c.client.GetCurrentSummoner()
+		resp, err := c.do("GET", "/lol-summoner/v1/current-summoner", nil)
 		if err != nil {
 			return nil, err
 		}
+		defer resp.Body.Close()
 
-		return &CurrentSummoner{
-			AccountID:                   lcuSummoner.AccountID,
-			DisplayName:                 lcuSummoner.DisplayName,
-			GameName:                    lcuSummoner.GameName,
-			TagLine:                     lcuSummoner.TagLine,
-			InternalName:                lcuSummoner.InternalName,
-			NameChangeFlag:              lcuSummoner.NameChangeFlag,
-			PercentCompleteForNextLevel: lcuSummoner.PercentCompleteForNextLevel,
-			ProfileIconID:               lcuSummoner.ProfileIconID,
-			PUUID:                       lcuSummoner.Puuid,
-			RerollPoints: RerollPoints{
-				CurrentPoints:    lcuSummoner.RerollPoints.CurrentPoints,
-				MaxRolls:         lcuSummoner.RerollPoints.MaxRolls,
-				NumberOfRolls:    lcuSummoner.RerollPoints.NumberOfRolls,
-				PointsCostToRoll: lcuSummoner.RerollPoints.PointsCostToRoll,
-				PointsToReroll:   lcuSummoner.RerollPoints.PointsToReroll,
-			},
-			SummonerID:       lcuSummoner.SummonerID,
-			SummonerLevel:    lcuSummoner.SummonerLevel,
-			XpSinceLastLevel: lcuSummoner.XpSinceLastLevel,
-			XpUntilNextLevel: lcuSummoner.XpUntilNextLevel,
-		}, nil
-	})
-}
+		if resp.StatusCode != http.StatusOK {
+			return nil, newAPIError("/lol-summoner/v1/current-summoner", resp)
+		}
 
-// buildClientHeaders creates HTTP headers for LCU API requests.
-func buildClientHeaders() map[string]string {
-	_, token, _ := getConnectionInfo()
-	headers := make(map[string]string)
-	if token != "" {
-		headers["Authorization"] = fmt.Sprintf("Basic %s", base64Encode(fmt.Sprintf("riot:%s", token)))
-	}
-	headers["Accept"] = "application/json"
-	return headers
+		var summoner CurrentSummoner
+		if err := json.NewDecoder(resp.Body).Decode(&summoner); err != nil {
+			return nil, fmt.Errorf("failed to decode current summoner: %w", err)
+		}
+
+		return &summoner, nil
+	})
 }
 
-// GetConnectionInfo returns the current LCU connection info, or nil if not connected.
-func GetConnectionInfo() *ConnectionInfo {
-	port, token, err := getConnectionInfo()
+// do sends an authenticated HTTP request to the LCU API.
+func (c *Client) do(method, endpoint string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(method, c.baseURL()+endpoint, body)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
-	return &ConnectionInfo{
-		Port:      port,
-		AuthToken: token,
+	req.Header.Set("Authorization", "Basic "+base64Encode("riot:"+c.info.AuthToken))
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
 	}
-}
 
-// IsClientRunning checks if the League client is running.
-func IsClientRunning() bool {
-	_, _, err := getConnectionInfo()
-	return err == nil
+	return c.httpClient.Do(req)
 }
 
-// ClearCache clears the cached connection info.
-func ClearCache() {
-	cacheMutex.Lock()
-	defer cacheMutex.Unlock()
-	cache = connectionCache{}
+// baseURL returns the HTTPS base URL of the LCU API.
+func (c *Client) baseURL() string {
+	return "https://" + c.address()
 }
 
-// getConnectionInfo returns cached or fresh connection info.
-func getConnectionInfo() (string, string, error) {
-	// Check cache
-	cacheMutex.RLock()
-	if cache.port != "" && time.Since(cache.updated) < cacheTTL {
-		port, token := cache.port, cache.token
-		cacheMutex.RUnlock()
-		return port, token, nil
+// address returns the host:port of the LCU API.
+func (c *Client) address() string {
+	host := c.info.Host
+	if host == "" {
+		host = defaultHost
 	}
-	cacheMutex.RUnlock()
+	return host + ":" + c.info.Port
+}
 
-	// Fetch new connection info
-	port, token, err := findFromProcess()
+// testConnection checks that the LCU API answers with the current credentials.
+func (c *Client) testConnection() error {
+	resp, err := c.do("GET", "/lol-summoner/v1/current-summoner", nil)
 	if err != nil {
-		ClearCache()
-		return "", "", err
+		return err
 	}
+	defer resp.Body.Close()
 
-	// Update cache
-	cacheMutex.Lock()
-	cache = connectionCache{port: port, token: token, updated: time.Now()}
-	cacheMutex.Unlock()
-
-	return port, token, nil
+	if resp.StatusCode != http.StatusOK {
+		return newAPIError("/lol-summoner/v1/current-summoner", resp)
+	}
+	return nil
 }
 
-// findFromProcess extracts LCU connection info from running process.
-func findFromProcess() (string, string, error) {
-	cmd := exec.Command("wmic", "process", "where", "name='LeagueClientUx.exe'", "get", "commandline")
-	cmd.SysProcAttr = &syscall.SysProcAttr{
-		HideWindow:    true,
-		CreationFlags: 0x08000000, // CREATE_NO_WINDOW
-	}
+// buildHeaders creates HTTP headers for LCU API requests made by this client.
+func (c *Client) buildHeaders() map[string]string {
+	return buildHeaders(c.info.AuthToken)
+}
 
-	output, err := cmd.Output()
-	if err != nil {
-		return "", "", fmt.Errorf("leagueClientUx.exe not running")
+// buildHeaders creates HTTP headers for LCU API requests with the given token.
+func buildHeaders(token string) map[string]string {
+	headers := make(map[string]string)
+	if token != "" {
+		headers["Authorization"] = fmt.Sprintf("Basic %s", base64Encode(fmt.Sprintf("riot:%s", token)))
 	}
+	headers["Accept"] = "application/json"
+	return headers
+}
 
-	return parseProcessArgs(string(output))
+// SetDefaultDiscoverer replaces the discoverer used by the package-level helpers.
+// The discoverer is wrapped in a cache so status checks stay cheap.
+func SetDefaultDiscoverer(discoverer ConnectionDiscoverer) {
+	discovererMutex.Lock()
+	defer discovererMutex.Unlock()
+	defaultDiscoverer = NewCachedDiscoverer(discoverer, cacheTTL)
 }
 
-// parseProcessArgs extracts port and token from process arguments.
-func parseProcessArgs(output string) (string, string, error) {
-	portRe := regexp.MustCompile(`--app-port=(\d+)`)
-	tokenRe := regexp.MustCompile(`--remoting-auth-token=([\w-]+)`)
+// DefaultDiscoverer returns the cached discoverer used by the package-level helpers.
+func DefaultDiscoverer() ConnectionDiscoverer {
+	discovererMutex.RLock()
+	defer discovererMutex.RUnlock()
+	return defaultDiscoverer
+}
 
-	portMatch := portRe.FindStringSubmatch(output)
-	if len(portMatch) < 2 {
-		return "", "", fmt.Errorf("league client port not found")
+// GetConnectionInfo returns the current LCU connection info, or nil if not connected.
+func GetConnectionInfo() *ConnectionInfo {
+	info, err := DefaultDiscoverer().Discover()
+	if err != nil {
+		return nil
 	}
+	return info
+}
 
-	tokenMatch := tokenRe.FindStringSubmatch(output)
-	if len(tokenMatch) < 2 {
-		return "", "", fmt.Errorf("auth token not found")
-	}
+// IsClientRunning checks if the League client is running.
+func IsClientRunning() bool {
+	return GetConnectionInfo() != nil
+}
 
-	return portMatch[1], tokenMatch[1], nil
+// ClearCache clears the cached connection info.
+func ClearCache() {
+	discovererMutex.RLock()
+	defer discovererMutex.RUnlock()
+	defaultDiscoverer.Clear()
 }
 
 // base64Encode encodes a string to base64.
@@ -222,72 +220,90 @@ func base64Encode(s string) string {
 	return base64.StdEncoding.EncodeToString([]byte(s))
 }
 
-// Request makes a raw HTTP request to the LCU API and logs it.
+// Request makes a raw HTTP request to the LCU API and logs each attempt.
+// Transient failures are retried according to the policy for the request's method.
 func (c *Client) Request(method, endpoint string, body io.Reader) ([]byte, error) {
 	if endpoint != "GetLCUStatus" && !IsConnected() {
 		return c.handleDisconnected(method, endpoint)
 	}
 
-	start := time.Now()
+	// Buffer the body so it can be sent again on a retry
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = io.ReadAll(body); err != nil {
+			return nil, err
+		}
+	}
+
 	headers := c.buildRequestHeaders(body)
+	policy := retryPolicy(method, endpoint)
 
-	resp, err := c.client.Request(method, endpoint, body)
-	duration := time.Since(start)
+	var data []byte
+	var status int
+	err := retry.Do(policy, func(attempt int) (int, error) {
+		if policy.MaxAttempts <= 1 {
+			attempt = 0
+		}
+		var err error
+		data, status, err = c.attempt(method, endpoint, payload, headers, attempt)
+		return status, err
+	})
 
 	if err != nil {
-		return c.handleRequestError(method, endpoint, duration, headers, err)
+		if status == 0 {
+			HandleConnectionError(err, endpoint)
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+// attempt sends a request once and logs it. It returns the response body and status
+// (0 if no response was received).
+func (c *Client) attempt(method, endpoint string, payload []byte, headers map[string]string, attempt int) ([]byte, int, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	start := time.Now()
+	resp, err := c.do(method, endpoint, body)
+	if err != nil {
+		LogAttempt(method, endpoint, attempt, 0, time.Since(start), headers, "", err)
+		return nil, 0, err
 	}
 	defer resp.Body.Close()
 
 	data, err := io.ReadAll(resp.Body)
+	duration := time.Since(start)
 	if err != nil {
-		return c.handleReadError(method, endpoint, duration, headers, err)
+		LogAttempt(method, endpoint, attempt, 0, duration, headers, "", err)
+		return nil, 0, err
 	}
 
-	return c.handleResponse(method, endpoint, resp, data, duration, headers)
+	responseBody := string(data)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		err := &APIError{Status: resp.StatusCode, Body: responseBody, Endpoint: endpoint}
+		LogAttempt(method, endpoint, attempt, resp.StatusCode, duration, headers, responseBody, err)
+		return nil, resp.StatusCode, err
+	}
+
+	LogAttempt(method, endpoint, attempt, resp.StatusCode, duration, headers, responseBody, nil)
+	return data, resp.StatusCode, nil
 }
 
 // handleDisconnected handles requests when client is disconnected.
 func (c *Client) handleDisconnected(method, endpoint string) ([]byte, error) {
-	err := fmt.Errorf("league client not connected")
-	headers := buildClientHeaders()
-	LogError(method, endpoint, 0, headers, err)
-	return nil, err
+	LogError(method, endpoint, 0, c.buildHeaders(), ErrNotConnected)
+	return nil, ErrNotConnected
 }
 
 // buildRequestHeaders builds headers for an HTTP request.
 func (c *Client) buildRequestHeaders(body io.Reader) map[string]string {
-	headers := buildClientHeaders()
+	headers := c.buildHeaders()
 	if body != nil {
 		headers["Content-Type"] = "application/json"
 	}
 	return headers
 }
-
-// handleRequestError handles errors from the HTTP request.
-func (c *Client) handleRequestError(method, endpoint string, duration time.Duration, headers map[string]string, err error) ([]byte, error) {
-	LogError(method, endpoint, duration, headers, err)
-	HandleConnectionError(err, endpoint)
-	return nil, err
-}
-
-// handleReadError handles errors from reading the response body.
-func (c *Client) handleReadError(method, endpoint string, duration time.Duration, headers map[string]string, err error) ([]byte, error) {
-	LogError(method, endpoint, duration, headers, err)
-	HandleConnectionError(err, endpoint)
-	return nil, err
-}
-
-// handleResponse handles the HTTP response.
-func (c *Client) handleResponse(method, endpoint string, resp *http.Response, data []byte, duration time.Duration, headers map[string]string) ([]byte, error) {
-	responseBody := string(data)
-
-	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
-		err := fmt.Errorf("lcu api error: %s - %s", resp.Status, responseBody)
-		LogRequest(method, endpoint, resp.StatusCode, duration, headers, responseBody, err)
-		return nil, err
-	}
-
-	LogSuccess(method, endpoint, resp.StatusCode, duration, headers, responseBody)
-	return data, nil
-}
@@ -1,7 +1,6 @@
 package lcu
 
 import (
-	"strings"
 	"sync"
 )
 
@@ -78,57 +77,6 @@ func CheckAndUpdateStatus() {
 	SetConnectionStatus(info != nil)
 }
 
-// IsConnectionRefusedError checks if an error indicates the client connection was refused.
-func IsConnectionRefusedError(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	errStr := strings.ToLower(err.Error())
-
-	patterns := []struct {
-		indicators []string
-		all        bool // true = all indicators must be present, false = any
-	}{
-		{indicators: []string{"dial tcp", "actively refused"}, all: true},
-		{indicators: []string{"connectex: no connection could be made"}, all: false},
-		{indicators: []string{"connection refused"}, all: false},
-		{indicators: []string{"league client not running"}, all: false},
-		{indicators: []string{"failed to connect"}, all: false},
-	}
-
-	for _, pattern := range patterns {
-		if matchesPattern(errStr, pattern.indicators, pattern.all) {
-			// Special case: exclude 404 errors for "failed to connect"
-			if strings.Contains(pattern.indicators[0], "failed to connect") && strings.Contains(errStr, "404") {
-				continue
-			}
-			return true
-		}
-	}
-
-	return false
-}
-
-// matchesPattern checks if error string matches a pattern.
-func matchesPattern(errStr string, indicators []string, all bool) bool {
-	if all {
-		for _, indicator := range indicators {
-			if !strings.Contains(errStr, indicator) {
-				return false
-			}
-		}
-		return true
-	}
-
-	for _, indicator := range indicators {
-		if strings.Contains(errStr, indicator) {
-			return true
-		}
-	}
-	return false
-}
-
 // HandleConnectionError handles a connection error by checking status and updating it.
 func HandleConnectionError(err error, endpoint string) bool {
 	if endpoint == "GetLCUStatus" || !IsConnectionRefusedError(err) {
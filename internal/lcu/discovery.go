@@ -0,0 +1,204 @@
+package lcu
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"lol-toolkit/internal/config"
+)
+
+// processName is the League client UX process that carries the LCU credentials.
+const processName = "LeagueClientUx"
+
+// lockfileName is the file the League client writes into its install directory.
+const lockfileName = "lockfile"
+
+var (
+	portArgRe  = regexp.MustCompile(`--app-port=(\d+)`)
+	tokenArgRe = regexp.MustCompile(`--remoting-auth-token=([\w-]+)`)
+)
+
+// ConnectionDiscoverer finds the connection details of a running League client.
+type ConnectionDiscoverer interface {
+	Discover() (*ConnectionInfo, error)
+}
+
+// ProcessDiscoverer reads connection info from the client process arguments.
+// The process scan is implemented per platform behind build tags.
+type ProcessDiscoverer struct{}
+
+// Discover implements ConnectionDiscoverer.
+func (ProcessDiscoverer) Discover() (*ConnectionInfo, error) {
+	port, token, err := findFromProcess()
+	if err != nil {
+		return nil, err
+	}
+	return &ConnectionInfo{Port: port, AuthToken: token}, nil
+}
+
+// LockfileDiscoverer reads connection info from the lockfile in an install directory.
+// An empty Dir searches the platform's default install directories.
+type LockfileDiscoverer struct {
+	Dir string
+}
+
+// Discover implements ConnectionDiscoverer.
+func (d LockfileDiscoverer) Discover() (*ConnectionInfo, error) {
+	dirs := installDirs()
+	if d.Dir != "" {
+		dirs = []string{d.Dir}
+	}
+
+	for _, dir := range dirs {
+		port, token, err := readLockfile(dir)
+		if err == nil {
+			return &ConnectionInfo{Port: port, AuthToken: token}, nil
+		}
+	}
+	return nil, fmt.Errorf("lockfile not found")
+}
+
+// StaticDiscoverer returns a fixed address, e.g. for a client running in a VM or container.
+type StaticDiscoverer struct {
+	Host      string
+	Port      string
+	AuthToken string
+}
+
+// Discover implements ConnectionDiscoverer.
+func (d StaticDiscoverer) Discover() (*ConnectionInfo, error) {
+	if d.Port == "" || d.AuthToken == "" {
+		return nil, fmt.Errorf("static connection requires a port and auth token")
+	}
+	return &ConnectionInfo{Host: d.Host, Port: d.Port, AuthToken: d.AuthToken}, nil
+}
+
+// ChainDiscoverer tries each discoverer in order and returns the first match.
+type ChainDiscoverer []ConnectionDiscoverer
+
+// Discover implements ConnectionDiscoverer.
+func (c ChainDiscoverer) Discover() (*ConnectionInfo, error) {
+	lastErr := errors.New("no discovery strategies configured")
+	for _, discoverer := range c {
+		info, err := discoverer.Discover()
+		if err == nil {
+			return info, nil
+		}
+		lastErr = err
+	}
+	return nil, fmt.Errorf("%w: %w", ErrClientNotRunning, lastErr)
+}
+
+// CachedDiscoverer remembers a successful discovery for a fixed TTL.
+type CachedDiscoverer struct {
+	discoverer ConnectionDiscoverer
+	ttl        time.Duration
+	info       *ConnectionInfo
+	updated    time.Time
+	mu         sync.RWMutex
+}
+
+// NewCachedDiscoverer wraps a discoverer with a TTL cache.
+func NewCachedDiscoverer(discoverer ConnectionDiscoverer, ttl time.Duration) *CachedDiscoverer {
+	return &CachedDiscoverer{
+		discoverer: discoverer,
+		ttl:        ttl,
+	}
+}
+
+// Discover implements ConnectionDiscoverer.
+func (c *CachedDiscoverer) Discover() (*ConnectionInfo, error) {
+	c.mu.RLock()
+	if c.info != nil && time.Since(c.updated) < c.ttl {
+		info := *c.info
+		c.mu.RUnlock()
+		return &info, nil
+	}
+	c.mu.RUnlock()
+
+	info, err := c.discoverer.Discover()
+	if err != nil {
+		c.Clear()
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.info = info
+	c.updated = time.Now()
+	c.mu.Unlock()
+
+	result := *info
+	return &result, nil
+}
+
+// Clear drops the cached connection info.
+func (c *CachedDiscoverer) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.info = nil
+}
+
+// NewDiscoverer builds a chain of discoverers from strategy names in priority order.
+// leaguePath is used by the lockfile strategy and static by the static strategy.
+func NewDiscoverer(order []string, leaguePath string, static StaticDiscoverer) (ConnectionDiscoverer, error) {
+	chain := make(ChainDiscoverer, 0, len(order))
+	for _, name := range order {
+		switch name {
+		case config.DiscoveryProcess:
+			chain = append(chain, ProcessDiscoverer{})
+		case config.DiscoveryLockfile:
+			chain = append(chain, LockfileDiscoverer{Dir: leaguePath})
+		case config.DiscoveryStatic:
+			chain = append(chain, static)
+		default:
+			return nil, fmt.Errorf("unknown lcu discovery strategy: %s", name)
+		}
+	}
+	return chain, nil
+}
+
+// readLockfile reads and parses the lockfile in the given install directory.
+func readLockfile(dir string) (string, string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, lockfileName))
+	if err != nil {
+		return "", "", err
+	}
+	return parseLockfile(string(data))
+}
+
+// parseLockfile extracts port and token from lockfile contents.
+// The format is name:pid:port:password:protocol.
+func parseLockfile(contents string) (string, string, error) {
+	parts := strings.Split(strings.TrimSpace(contents), ":")
+	if len(parts) != 5 {
+		return "", "", fmt.Errorf("invalid lockfile format")
+	}
+
+	port, token := parts[2], parts[3]
+	if port == "" || token == "" {
+		return "", "", fmt.Errorf("invalid lockfile format")
+	}
+
+	return port, token, nil
+}
+
+// parseProcessArgs extracts port and token from process arguments.
+func parseProcessArgs(output string) (string, string, error) {
+	portMatch := portArgRe.FindStringSubmatch(output)
+	if len(portMatch) < 2 {
+		return "", "", fmt.Errorf("league client port not found")
+	}
+
+	tokenMatch := tokenArgRe.FindStringSubmatch(output)
+	if len(tokenMatch) < 2 {
+		return "", "", fmt.Errorf("auth token not found")
+	}
+
+	return portMatch[1], tokenMatch[1], nil
+}
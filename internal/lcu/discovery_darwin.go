@@ -0,0 +1,34 @@
+//go:build darwin
+
+package lcu
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// findFromProcess extracts LCU connection info from ps output.
+func findFromProcess() (string, string, error) {
+	output, err := exec.Command("ps", "-A", "-o", "command").Output()
+	if err != nil {
+		return "", "", err
+	}
+
+	for _, line := range strings.Split(string(output), "\n") {
+		if !strings.Contains(line, processName) {
+			continue
+		}
+
+		if port, token, err := parseProcessArgs(line); err == nil {
+			return port, token, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("%s not running", processName)
+}
+
+// installDirs returns the default League install directory on macOS.
+func installDirs() []string {
+	return []string{"/Applications/League of Legends.app/Contents/LoL"}
+}
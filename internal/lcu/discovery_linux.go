@@ -0,0 +1,51 @@
+//go:build linux
+
+package lcu
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// findFromProcess scans /proc for the client process, which covers Wine and Lutris setups.
+func findFromProcess() (string, string, error) {
+	matches, err := filepath.Glob("/proc/[0-9]*/cmdline")
+	if err != nil {
+		return "", "", err
+	}
+
+	for _, path := range matches {
+		data, err := os.ReadFile(path)
+		if err != nil || len(data) == 0 {
+			continue
+		}
+
+		// Arguments in cmdline are NUL-separated
+		cmdline := strings.ReplaceAll(string(data), "\x00", " ")
+		if !strings.Contains(cmdline, processName) {
+			continue
+		}
+
+		if port, token, err := parseProcessArgs(cmdline); err == nil {
+			return port, token, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("%s not running", processName)
+}
+
+// installDirs returns the usual Wine prefix install directories on Linux.
+func installDirs() []string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+
+	gameDir := filepath.Join("drive_c", "Riot Games", "League of Legends")
+	return []string{
+		filepath.Join(home, "Games", "league-of-legends", gameDir),
+		filepath.Join(home, ".wine", gameDir),
+	}
+}
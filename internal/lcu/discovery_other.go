@@ -0,0 +1,15 @@
+//go:build !windows && !linux && !darwin
+
+package lcu
+
+import "fmt"
+
+// findFromProcess is not supported on this platform.
+func findFromProcess() (string, string, error) {
+	return "", "", fmt.Errorf("process discovery not supported on this platform")
+}
+
+// installDirs returns no default install directories on this platform.
+func installDirs() []string {
+	return nil
+}
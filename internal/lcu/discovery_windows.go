@@ -0,0 +1,34 @@
+//go:build windows
+
+package lcu
+
+import (
+	"fmt"
+	"os/exec"
+	"syscall"
+)
+
+// findFromProcess extracts LCU connection info from the running process via wmic.
+func findFromProcess() (string, string, error) {
+	cmd := exec.Command("wmic", "process", "where", "name='LeagueClientUx.exe'", "get", "commandline")
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		HideWindow:    true,
+		CreationFlags: 0x08000000, // CREATE_NO_WINDOW
+	}
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", "", fmt.Errorf("leagueClientUx.exe not running")
+	}
+
+	return parseProcessArgs(string(output))
+}
+
+// installDirs returns the default League install directories on Windows.
+func installDirs() []string {
+	var dirs []string
+	for _, drive := range []string{"C", "D", "E", "F"} {
+		dirs = append(dirs, drive+`:\Riot Games\League of Legends`)
+	}
+	return dirs
+}
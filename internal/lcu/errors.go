@@ -0,0 +1,60 @@
+package lcu
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+
+	"lol-toolkit/internal/apierr"
+)
+
+// Errors returned when the League client cannot be reached.
+var (
+	// ErrNotConnected is returned without making a request while the connection is known to be down.
+	ErrNotConnected = apierr.New(apierr.CodeNotConnected, "league client not connected")
+	// ErrClientNotRunning is returned when no League client could be discovered.
+	ErrClientNotRunning = apierr.New(apierr.CodeClientNotRunning, "league client not running")
+)
+
+// APIError is an error response from the LCU API.
+type APIError struct {
+	Status   int
+	Body     string
+	Endpoint string
+}
+
+// Error implements error.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("lcu api error: %d %s - %s", e.Status, http.StatusText(e.Status), e.Body)
+}
+
+// StatusCode returns the HTTP status of the response.
+func (e *APIError) StatusCode() int {
+	return e.Status
+}
+
+// newAPIError builds an APIError from an error response, reading its body.
+func newAPIError(endpoint string, resp *http.Response) *APIError {
+	body, _ := io.ReadAll(resp.Body)
+	return &APIError{Status: resp.StatusCode, Body: string(body), Endpoint: endpoint}
+}
+
+// IsNotFound reports whether err is a 404 from the LCU API, which the client returns
+// for resources that do not exist yet (no ready check, not in champ select, ...).
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound
+}
+
+// IsConnectionRefusedError reports whether err means the League client is not reachable:
+// it is not running or the connection to it could not be established.
+func IsConnectionRefusedError(err error) bool {
+	if errors.Is(err, ErrClientNotRunning) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
@@ -0,0 +1,205 @@
+package lcu
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// subscriptionQueueSize is how many events a subscription can fall behind before new ones are dropped.
+const subscriptionQueueSize = 64
+
+// EventBus owns a single LCU WebSocket connection and fans its events out to subscribers.
+// Subscriptions live on the bus rather than the connection, so they survive reconnects.
+// Each subscription has its own queue and goroutine, so a handler that makes HTTP calls
+// only delays its own later events, not other subscribers or reads from the socket.
+type EventBus struct {
+	ws            *WebSocketClient
+	subscriptions map[int]*subscription
+	nextID        int
+	connMu        sync.Mutex
+	mu            sync.RWMutex
+}
+
+// subscription is a single handler registered on the bus, with the events waiting for it.
+type subscription struct {
+	pattern    string
+	eventTypes []EventType
+	handler    func(*Event)
+	queue      chan *Event
+	removed    atomic.Bool // set on unsubscribe, so events still queued are dropped
+}
+
+// NewEventBus creates a new, disconnected event bus.
+func NewEventBus() *EventBus {
+	return &EventBus{
+		subscriptions: make(map[int]*subscription),
+	}
+}
+
+// Connect opens the WebSocket on client unless the bus is already connected.
+func (b *EventBus) Connect(client *Client) error {
+	b.connMu.Lock()
+	defer b.connMu.Unlock()
+
+	if b.ws != nil && !isClosed(b.ws.Done()) {
+		return nil
+	}
+
+	ws, err := client.NewWebSocketClient()
+	if err != nil {
+		return err
+	}
+	if err := ws.SubscribeToAll(b.dispatch); err != nil {
+		return err
+	}
+	if err := ws.Start(); err != nil {
+		return err
+	}
+
+	b.ws = ws
+	return nil
+}
+
+// Disconnect closes the WebSocket. Subscriptions are kept for the next Connect.
+func (b *EventBus) Disconnect() {
+	b.connMu.Lock()
+	ws := b.ws
+	b.ws = nil
+	b.connMu.Unlock()
+
+	if ws != nil {
+		ws.Stop()
+	}
+}
+
+// Connected returns true if the WebSocket is open.
+func (b *EventBus) Connected() bool {
+	return !isClosed(b.Done())
+}
+
+// Done returns a channel that is closed when the current connection drops.
+// If the bus is not connected, the returned channel is already closed.
+func (b *EventBus) Done() <-chan struct{} {
+	b.connMu.Lock()
+	ws := b.ws
+	b.connMu.Unlock()
+
+	if ws == nil {
+		return closedChan
+	}
+	return ws.Done()
+}
+
+// Subscribe registers a handler for events whose URI matches pattern.
+// A pattern ending in "*" matches any URI with that prefix; otherwise the URI must match exactly.
+// With no event types, all event types are delivered. The returned function unsubscribes.
+// The handler runs on the subscription's own goroutine, one event at a time and in order.
+// If it falls subscriptionQueueSize events behind, newer events are dropped until it catches up.
+func (b *EventBus) Subscribe(pattern string, handler func(*Event), eventTypes ...EventType) func() {
+	sub := &subscription{
+		pattern:    pattern,
+		eventTypes: eventTypes,
+		handler:    handler,
+		queue:      make(chan *Event, subscriptionQueueSize),
+	}
+	go sub.run()
+
+	b.mu.Lock()
+	id := b.nextID
+	b.nextID++
+	b.subscriptions[id] = sub
+	b.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			delete(b.subscriptions, id)
+			sub.removed.Store(true)
+			close(sub.queue)
+		})
+	}
+}
+
+// SubscribeJSON registers a handler that receives the event data decoded into T.
+// Events whose data does not decode into T are skipped; Delete events carry the zero value.
+func SubscribeJSON[T any](b *EventBus, pattern string, handler func(event *Event, data T), eventTypes ...EventType) func() {
+	return b.Subscribe(pattern, func(event *Event) {
+		var data T
+		if len(event.Data) > 0 {
+			if err := json.Unmarshal(event.Data, &data); err != nil {
+				return
+			}
+		}
+		handler(event, data)
+	}, eventTypes...)
+}
+
+// dispatch queues the event for every subscription that matches it. It never waits for a handler.
+func (b *EventBus) dispatch(event *Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, sub := range b.subscriptions {
+		if !sub.matches(event) {
+			continue
+		}
+		select {
+		case sub.queue <- event:
+		default: // the handler is too far behind
+		}
+	}
+}
+
+// run calls the handler for every queued event until the subscription is removed.
+func (s *subscription) run() {
+	for event := range s.queue {
+		if !s.removed.Load() {
+			s.handler(event)
+		}
+	}
+}
+
+// matches returns true if the subscription wants the event.
+func (s *subscription) matches(event *Event) bool {
+	if !matchURI(s.pattern, event.URI) {
+		return false
+	}
+	if len(s.eventTypes) == 0 {
+		return true
+	}
+	for _, eventType := range s.eventTypes {
+		if event.EventType == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// matchURI matches a URI against an exact or trailing-"*" prefix pattern.
+func matchURI(pattern, uri string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(uri, prefix)
+	}
+	return pattern == uri
+}
+
+// closedChan is a pre-closed channel returned by Done when there is no connection.
+var closedChan = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
+
+// isClosed reports whether a done channel has been closed.
+func isClosed(done <-chan struct{}) bool {
+	select {
+	case <-done:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,71 @@
+package lcu
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSlowHandlerDoesNotBlockOtherSubscribers(t *testing.T) {
+	bus := NewEventBus()
+
+	release := make(chan struct{})
+	defer close(release)
+	unsubscribeSlow := bus.Subscribe("/lol-gameflow/*", func(*Event) {
+		<-release // e.g. an HTTP call to a client that stopped answering
+	})
+	defer unsubscribeSlow()
+
+	received := make(chan string, 10)
+	unsubscribe := bus.Subscribe("/lol-matchmaking/v1/ready-check", func(event *Event) {
+		received <- event.URI
+	})
+	defer unsubscribe()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		bus.dispatch(&Event{URI: "/lol-gameflow/v1/gameflow-phase", EventType: EventTypeUpdate})
+		bus.dispatch(&Event{URI: "/lol-gameflow/v1/session", EventType: EventTypeUpdate})
+		bus.dispatch(&Event{URI: "/lol-matchmaking/v1/ready-check", EventType: EventTypeUpdate})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("dispatch waited for a slow handler")
+	}
+	select {
+	case uri := <-received:
+		if uri != "/lol-matchmaking/v1/ready-check" {
+			t.Fatalf("received %q", uri)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("ready-check handler was not called while another handler was busy")
+	}
+}
+
+func TestSubscriptionKeepsEventOrder(t *testing.T) {
+	bus := NewEventBus()
+
+	received := make(chan string, subscriptionQueueSize)
+	unsubscribe := bus.Subscribe("/lol-gameflow/*", func(event *Event) {
+		time.Sleep(time.Millisecond)
+		received <- event.URI
+	})
+	defer unsubscribe()
+
+	uris := []string{"/lol-gameflow/a", "/lol-gameflow/b", "/lol-gameflow/c"}
+	for _, uri := range uris {
+		bus.dispatch(&Event{URI: uri, EventType: EventTypeUpdate})
+	}
+	for _, want := range uris {
+		select {
+		case got := <-received:
+			if got != want {
+				t.Fatalf("received %q, want %q", got, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("%q was not delivered", want)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package lcu
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// gameflowSession holds the parts of the gameflow session used by the toolkit.
+type gameflowSession struct {
+	GameData struct {
+		Queue struct {
+			ID int `json:"id"`
+		} `json:"queue"`
+	} `json:"gameData"`
+}
+
+// GetGameflowQueueID gets the queue ID of the current lobby or game.
+func (c *Client) GetGameflowQueueID() (int, error) {
+	data, err := c.Request("GET", "/lol-gameflow/v1/session", nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var session gameflowSession
+	if err := json.Unmarshal(data, &session); err != nil {
+		return 0, fmt.Errorf("failed to decode gameflow session: %w", err)
+	}
+	return session.GameData.Queue.ID, nil
+}
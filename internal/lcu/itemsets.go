@@ -0,0 +1,119 @@
+package lcu
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// managedItemSetUID prefixes the UID of item sets owned by the toolkit.
+const managedItemSetUID = "lol-toolkit-"
+
+// ItemSet represents an LCU item set.
+type ItemSet struct {
+	UID                 string         `json:"uid"`
+	Title               string         `json:"title"`
+	Type                string         `json:"type"`
+	Map                 string         `json:"map"`
+	Mode                string         `json:"mode"`
+	AssociatedChampions []int          `json:"associatedChampions"`
+	AssociatedMaps      []int          `json:"associatedMaps"`
+	Blocks              []ItemSetBlock `json:"blocks"`
+	Sortrank            int            `json:"sortrank"`
+	StartedFrom         string         `json:"startedFrom"`
+	PreferredItemSlots  []interface{}  `json:"preferredItemSlots"`
+}
+
+// ItemSetBlock is a titled group of items in an item set.
+type ItemSetBlock struct {
+	Type  string        `json:"type"`
+	Items []ItemSetItem `json:"items"`
+}
+
+// ItemSetItem is an item and count inside an item set block.
+type ItemSetItem struct {
+	ID    string `json:"id"`
+	Count int    `json:"count"`
+}
+
+// ApplyManagedItemSet replaces the toolkit-owned item set for a champion.
+// Item sets created in the client are kept as they are.
+func (c *Client) ApplyManagedItemSet(summonerID int64, championID int, set ItemSet) error {
+	endpoint := fmt.Sprintf("/lol-item-sets/v1/item-sets/%d/sets", summonerID)
+
+	data, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	// Decode loosely so fields the toolkit does not know about survive the round trip
+	var sets map[string]json.RawMessage
+	if err := json.Unmarshal(data, &sets); err != nil {
+		return fmt.Errorf("failed to decode item sets: %w", err)
+	}
+
+	var existing []json.RawMessage
+	if raw, ok := sets["itemSets"]; ok {
+		if err := json.Unmarshal(raw, &existing); err != nil {
+			return fmt.Errorf("failed to decode item sets: %w", err)
+		}
+	}
+
+	uid := fmt.Sprintf("%s%d", managedItemSetUID, championID)
+	kept := make([]json.RawMessage, 0, len(existing)+1)
+	for _, raw := range existing {
+		var head struct {
+			UID string `json:"uid"`
+		}
+		if json.Unmarshal(raw, &head) == nil && head.UID == uid {
+			continue
+		}
+		kept = append(kept, raw)
+	}
+
+	set.UID = uid
+	set.AssociatedChampions = []int{championID}
+	if set.Type == "" {
+		set.Type = "custom"
+	}
+	if set.Map == "" {
+		set.Map = "any"
+	}
+	if set.Mode == "" {
+		set.Mode = "any"
+	}
+	if set.StartedFrom == "" {
+		set.StartedFrom = "blank"
+	}
+	if set.AssociatedMaps == nil {
+		set.AssociatedMaps = []int{}
+	}
+	if set.PreferredItemSlots == nil {
+		set.PreferredItemSlots = []interface{}{}
+	}
+	if !strings.HasPrefix(set.Title, ManagedTitlePrefix) {
+		set.Title = ManagedTitlePrefix + set.Title
+	}
+
+	raw, err := json.Marshal(set)
+	if err != nil {
+		return err
+	}
+	kept = append(kept, raw)
+
+	if sets == nil {
+		sets = make(map[string]json.RawMessage)
+	}
+	if sets["itemSets"], err = json.Marshal(kept); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(sets)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Request("PUT", endpoint, bytes.NewReader(body))
+	return err
+}
@@ -1,14 +1,68 @@
 package lcu
 
 import (
-	"fmt"
+	"net/http"
+	"sync"
 	"time"
 
 	"lol-toolkit/internal/logger"
+	"lol-toolkit/internal/retry"
 )
 
 const apiType = "lcu"
 
+// Retry classes for LCU requests
+const (
+	RetryClassRead  = "read"  // GET requests
+	RetryClassWrite = "write" // requests that change client state
+	RetryClassProbe = "probe" // connection checks, which must fail fast
+)
+
+// retryPolicies maps a retry class to its policy. The LCU is local, so delays are short.
+// Writes are only retried when the connection could not be opened: after a timeout or a
+// reset the request may already have been applied, e.g. a rune page created or a pick locked.
+var (
+	retryPolicies = map[string]retry.Policy{
+		RetryClassRead: {
+			MaxAttempts:     3,
+			BaseDelay:       200 * time.Millisecond,
+			MaxDelay:        time.Second,
+			RetryableStatus: []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+			RetryNetwork:    true,
+		},
+		RetryClassWrite: {
+			MaxAttempts: 2,
+			BaseDelay:   200 * time.Millisecond,
+			MaxDelay:    time.Second,
+			RetryDial:   true,
+		},
+		RetryClassProbe: retry.NoRetry,
+	}
+	retryMutex sync.RWMutex
+)
+
+// SetRetryPolicy sets the retry policy for a retry class.
+func SetRetryPolicy(class string, policy retry.Policy) {
+	retryMutex.Lock()
+	defer retryMutex.Unlock()
+	retryPolicies[class] = policy
+}
+
+// retryPolicy returns the retry policy for a request.
+func retryPolicy(method, endpoint string) retry.Policy {
+	class := RetryClassWrite
+	switch {
+	case endpoint == "GetLCUStatus":
+		class = RetryClassProbe
+	case method == http.MethodGet:
+		class = RetryClassRead
+	}
+
+	retryMutex.RLock()
+	defer retryMutex.RUnlock()
+	return retryPolicies[class]
+}
+
 // APILogEntry is an alias for logger.APILogEntry for backward compatibility.
 type APILogEntry = logger.APILogEntry
 
@@ -17,13 +71,13 @@ func SetAPILogger(loggerFunc func(entry APILogEntry)) {
 	logger.SetAPILogger(loggerFunc)
 }
 
-// LoggedCall wraps an API call with automatic timing and logging.
+// LoggedCall wraps an API call with automatic timing, logging and retries.
 func LoggedCall[T any](method, endpoint string, statusCode int, headers map[string]string, fn func() (T, error)) (T, error) {
 	if shouldBlockCall(endpoint) {
 		return handleBlockedCall[T](method, endpoint, headers)
 	}
 
-	result, err := logger.LoggedCall(apiType, method, endpoint, statusCode, headers, fn)
+	result, err := logger.LoggedRetryCall(apiType, retryPolicy(method, endpoint), method, endpoint, statusCode, headers, fn)
 
 	if err != nil {
 		HandleConnectionError(err, endpoint)
@@ -40,9 +94,8 @@ func shouldBlockCall(endpoint string) bool {
 // handleBlockedCall handles a blocked API call.
 func handleBlockedCall[T any](method, endpoint string, headers map[string]string) (T, error) {
 	var zero T
-	err := fmt.Errorf("league client not connected")
-	logger.LogError(apiType, method, endpoint, 0, headers, err)
-	return zero, err
+	logger.LogError(apiType, method, endpoint, 0, headers, ErrNotConnected)
+	return zero, ErrNotConnected
 }
 
 // LogSuccess logs a successful API call.
@@ -59,3 +112,8 @@ func LogError(method, endpoint string, duration time.Duration, headers map[strin
 func LogRequest(method, endpoint string, statusCode int, duration time.Duration, headers map[string]string, response string, err error) {
 	logger.LogRequest(apiType, method, endpoint, statusCode, duration, headers, response, err)
 }
+
+// LogAttempt logs one attempt of a retried API call.
+func LogAttempt(method, endpoint string, attempt, statusCode int, duration time.Duration, headers map[string]string, response string, err error) {
+	logger.LogAttempt(apiType, method, endpoint, attempt, statusCode, duration, headers, response, err)
+}
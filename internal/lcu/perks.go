@@ -0,0 +1,135 @@
+package lcu
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ManagedTitlePrefix tags rune pages and item sets owned by the toolkit, so they can be
+// reused instead of taking another slot.
+const ManagedTitlePrefix = "[Toolkit] "
+
+// RunePage represents an LCU rune page.
+type RunePage struct {
+	ID              int64  `json:"id,omitempty"`
+	Name            string `json:"name"`
+	PrimaryStyleID  int    `json:"primaryStyleId"`
+	SubStyleID      int    `json:"subStyleId"`
+	SelectedPerkIDs []int  `json:"selectedPerkIds"`
+	Current         bool   `json:"current"`
+	IsEditable      bool   `json:"isEditable,omitempty"`
+	IsDeletable     bool   `json:"isDeletable,omitempty"`
+}
+
+// perkInventory holds the rune page slot count.
+type perkInventory struct {
+	OwnedPageCount int `json:"ownedPageCount"`
+}
+
+// GetRunePages gets all rune pages.
+func (c *Client) GetRunePages() ([]RunePage, error) {
+	data, err := c.Request("GET", "/lol-perks/v1/pages", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pages []RunePage
+	if err := json.Unmarshal(data, &pages); err != nil {
+		return nil, fmt.Errorf("failed to decode rune pages: %w", err)
+	}
+	return pages, nil
+}
+
+// GetCurrentRunePage gets the currently selected rune page.
+func (c *Client) GetCurrentRunePage() (*RunePage, error) {
+	data, err := c.Request("GET", "/lol-perks/v1/currentpage", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var page RunePage
+	if err := json.Unmarshal(data, &page); err != nil {
+		return nil, fmt.Errorf("failed to decode rune page: %w", err)
+	}
+	return &page, nil
+}
+
+// CreateRunePage creates a rune page and makes it current.
+func (c *Client) CreateRunePage(page RunePage) (*RunePage, error) {
+	page.ID = 0
+	page.Current = true
+
+	body, err := json.Marshal(page)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.Request("POST", "/lol-perks/v1/pages", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var created RunePage
+	if err := json.Unmarshal(data, &created); err != nil {
+		return nil, fmt.Errorf("failed to decode rune page: %w", err)
+	}
+	return &created, nil
+}
+
+// DeleteRunePage deletes a rune page.
+func (c *Client) DeleteRunePage(id int64) error {
+	_, err := c.Request("DELETE", fmt.Sprintf("/lol-perks/v1/pages/%d", id), nil)
+	return err
+}
+
+// ApplyManagedRunePage writes page into the toolkit-owned rune page and makes it current.
+// An existing toolkit page is replaced; otherwise a new page is created if a slot is free.
+func (c *Client) ApplyManagedRunePage(page RunePage) (*RunePage, error) {
+	if !strings.HasPrefix(page.Name, ManagedTitlePrefix) {
+		page.Name = ManagedTitlePrefix + page.Name
+	}
+
+	pages, err := c.GetRunePages()
+	if err != nil {
+		return nil, err
+	}
+
+	editable := 0
+	for _, existing := range pages {
+		if !existing.IsEditable {
+			continue
+		}
+		editable++
+
+		if strings.HasPrefix(existing.Name, ManagedTitlePrefix) && existing.IsDeletable {
+			// Reuse the toolkit's slot
+			if err := c.DeleteRunePage(existing.ID); err != nil {
+				return nil, err
+			}
+			return c.CreateRunePage(page)
+		}
+	}
+
+	inventory, err := c.getPerkInventory()
+	if err == nil && inventory.OwnedPageCount > 0 && editable >= inventory.OwnedPageCount {
+		return nil, fmt.Errorf("no free rune page slot: delete a page or rename one with the %q prefix", strings.TrimSpace(ManagedTitlePrefix))
+	}
+
+	return c.CreateRunePage(page)
+}
+
+// getPerkInventory gets the rune page inventory.
+func (c *Client) getPerkInventory() (*perkInventory, error) {
+	data, err := c.Request("GET", "/lol-perks/v1/inventory", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var inventory perkInventory
+	if err := json.Unmarshal(data, &inventory); err != nil {
+		return nil, fmt.Errorf("failed to decode perk inventory: %w", err)
+	}
+	return &inventory, nil
+}
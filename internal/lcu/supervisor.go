@@ -0,0 +1,253 @@
+package lcu
+
+import (
+	"sync"
+	"time"
+)
+
+// SupervisorState describes the supervisor's view of the League client connection.
+type SupervisorState string
+
+const (
+	SupervisorStateSearching    SupervisorState = "Searching"    // no client found yet
+	SupervisorStateConnecting   SupervisorState = "Connecting"   // credentials found, opening the connection
+	SupervisorStateConnected    SupervisorState = "Connected"    // HTTP and WebSocket are up
+	SupervisorStateReconnecting SupervisorState = "Reconnecting" // connection lost, re-discovering credentials
+)
+
+// Supervisor timing settings.
+const (
+	minBackoff    = 1 * time.Second
+	maxBackoff    = 30 * time.Second
+	watchInterval = 10 * time.Second // how often credentials are re-checked while connected
+)
+
+// Supervisor keeps an LCU connection alive across client restarts.
+// It re-discovers credentials with exponential backoff, rebuilds the Client,
+// reconnects the event bus and notifies dependent services.
+type Supervisor struct {
+	discoverer    ConnectionDiscoverer
+	events        *EventBus
+	client        *Client
+	state         SupervisorState
+	wasConnected  bool
+	onStateChange func(state SupervisorState)
+	onConnect     []func(client *Client)
+	mu            sync.RWMutex
+	stop          chan struct{}
+	wg            sync.WaitGroup
+}
+
+// NewSupervisor creates a supervisor that discovers the client with discoverer
+// and keeps events connected. The discoverer should not cache results.
+func NewSupervisor(discoverer ConnectionDiscoverer, events *EventBus) *Supervisor {
+	return &Supervisor{
+		discoverer: discoverer,
+		events:     events,
+		state:      SupervisorStateSearching,
+	}
+}
+
+// Start starts the supervisor goroutine.
+func (s *Supervisor) Start() {
+	s.mu.Lock()
+	if s.stop != nil {
+		s.mu.Unlock()
+		return
+	}
+	s.stop = make(chan struct{})
+	stop := s.stop
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go s.run(stop)
+}
+
+// Stop stops the supervisor and closes the event bus connection.
+func (s *Supervisor) Stop() {
+	s.mu.Lock()
+	stop := s.stop
+	s.stop = nil
+	s.mu.Unlock()
+
+	if stop == nil {
+		return
+	}
+
+	close(stop)
+	s.wg.Wait()
+	s.events.Disconnect()
+}
+
+// Client returns the current client, or nil if not connected.
+func (s *Supervisor) Client() *Client {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.client
+}
+
+// State returns the current supervisor state.
+func (s *Supervisor) State() SupervisorState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.state
+}
+
+// SetOnStateChange sets a callback to be called when the supervisor state changes.
+func (s *Supervisor) SetOnStateChange(callback func(state SupervisorState)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onStateChange = callback
+}
+
+// OnConnect registers a callback to be called with the new client after every (re)connect.
+func (s *Supervisor) OnConnect(callback func(client *Client)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onConnect = append(s.onConnect, callback)
+}
+
+// run is the main loop of the supervisor.
+func (s *Supervisor) run(stop chan struct{}) {
+	defer s.wg.Done()
+
+	backoff := minBackoff
+	for {
+		client, err := s.connect()
+		if err != nil {
+			if !sleep(stop, backoff) {
+				return
+			}
+			backoff = min(backoff*2, maxBackoff)
+			continue
+		}
+
+		backoff = minBackoff
+		s.setConnected(client)
+
+		if !s.watch(stop, client) {
+			return
+		}
+
+		s.setDisconnected()
+	}
+}
+
+// connect discovers credentials and opens the HTTP client and event bus.
+func (s *Supervisor) connect() (*Client, error) {
+	info, err := s.discoverer.Discover()
+	if err != nil {
+		return nil, err
+	}
+
+	s.setState(SupervisorStateConnecting)
+
+	client, err := NewClient(StaticDiscoverer{Host: info.Host, Port: info.Port, AuthToken: info.AuthToken})
+	if err != nil {
+		s.setState(s.searchState())
+		return nil, err
+	}
+
+	s.events.Disconnect()
+	if err := s.events.Connect(client); err != nil {
+		s.setState(s.searchState())
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// watch blocks until the connection drops or the client restarts with new credentials.
+// It returns false if the supervisor was stopped.
+func (s *Supervisor) watch(stop chan struct{}, client *Client) bool {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return false
+		case <-s.events.Done():
+			return true
+		case <-ticker.C:
+			if s.credentialsChanged(client) {
+				return true
+			}
+		}
+	}
+}
+
+// credentialsChanged returns true if the client restarted or went away.
+// A failed discovery only counts if the current client also stopped answering.
+func (s *Supervisor) credentialsChanged(client *Client) bool {
+	info, err := s.discoverer.Discover()
+	if err != nil {
+		return client.testConnection() != nil
+	}
+	return info.Port != client.info.Port || info.AuthToken != client.info.AuthToken
+}
+
+// setConnected records a new client and notifies dependent services.
+func (s *Supervisor) setConnected(client *Client) {
+	s.mu.Lock()
+	s.client = client
+	s.wasConnected = true
+	callbacks := append([]func(*Client){}, s.onConnect...)
+	s.mu.Unlock()
+
+	ClearCache()
+	SetConnectionStatus(true)
+	s.setState(SupervisorStateConnected)
+
+	for _, callback := range callbacks {
+		callback(client)
+	}
+}
+
+// setDisconnected drops the current client after a lost connection.
+func (s *Supervisor) setDisconnected() {
+	s.mu.Lock()
+	s.client = nil
+	s.mu.Unlock()
+
+	s.events.Disconnect()
+	ClearCache()
+	SetConnectionStatus(false)
+	s.setState(SupervisorStateReconnecting)
+}
+
+// searchState returns the state to report while looking for a client.
+func (s *Supervisor) searchState() SupervisorState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.wasConnected {
+		return SupervisorStateReconnecting
+	}
+	return SupervisorStateSearching
+}
+
+// setState updates the state and fires the callback if it changed.
+func (s *Supervisor) setState(state SupervisorState) {
+	s.mu.Lock()
+	changed := s.state != state
+	s.state = state
+	callback := s.onStateChange
+	s.mu.Unlock()
+
+	if changed && callback != nil {
+		callback(state)
+	}
+}
+
+// sleep waits for d and returns false if stop was closed first.
+func sleep(stop chan struct{}, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-stop:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -1,41 +1,183 @@
 package lcu
 
 import (
-	lcuclient "github.com/its-haze/lcu-gopher"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// WAMP message opcodes used by the LCU WebSocket.
+const (
+	wampSubscribe = 5
+	wampEvent     = 8
 )
 
-// WebSocketClient wraps lcu-gopher's WebSocket functionality.
-// Use the Client's GetLCUClient() method to access lcu-gopher's Subscribe methods directly.
+// jsonAPIEventTopic is the WAMP topic that carries every LCU JSON API event.
+const jsonAPIEventTopic = "OnJsonApiEvent"
+
+// allEventsKey is the handler key for subscriptions to every URI.
+const allEventsKey = "/"
+
+// EventType represents the type of an LCU WebSocket event.
+type EventType string
+
+const (
+	EventTypeCreate EventType = "Create"
+	EventTypeUpdate EventType = "Update"
+	EventTypeDelete EventType = "Delete"
+)
+
+// Event represents a single LCU JSON API event.
+type Event struct {
+	EventType EventType       `json:"eventType"`
+	URI       string          `json:"uri"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// WebSocketClient is a minimal WAMP client for the LCU WebSocket.
 type WebSocketClient struct {
-	client *lcuclient.Client
+	client   *Client
+	conn     *websocket.Conn
+	handlers map[string][]func(*Event)
+	done     chan struct{}
+	mu       sync.RWMutex
 }
 
-// NewWebSocketClient creates a new WebSocket client using lcu-gopher.
-// Note: The underlying lcu-gopher client already has WebSocket support built-in.
-// Use Client.GetLCUClient() to access Subscribe methods directly.
+// NewWebSocketClient creates a new WebSocket client for this LCU connection.
+// Call Start to open the connection.
 func (c *Client) NewWebSocketClient() (*WebSocketClient, error) {
 	return &WebSocketClient{
-		client: c.client,
+		client:   c,
+		handlers: make(map[string][]func(*Event)),
 	}, nil
 }
 
-// Subscribe subscribes to an event using lcu-gopher's event system.
-func (ws *WebSocketClient) Subscribe(endpoint string, handler func(*lcuclient.Event), eventTypes ...lcuclient.EventType) error {
-	return ws.client.Subscribe(endpoint, handler, eventTypes...)
+// Subscribe registers a handler for events on an endpoint, filtered by event type.
+func (ws *WebSocketClient) Subscribe(endpoint string, handler func(*Event), eventTypes ...EventType) error {
+	if len(eventTypes) == 0 {
+		return fmt.Errorf("at least one event type must be specified")
+	}
+
+	wrapped := func(event *Event) {
+		for _, eventType := range eventTypes {
+			if event.EventType == eventType {
+				handler(event)
+				return
+			}
+		}
+	}
+
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.handlers[endpoint] = append(ws.handlers[endpoint], wrapped)
+	return nil
 }
 
 // SubscribeToAll subscribes to all events.
-func (ws *WebSocketClient) SubscribeToAll(handler func(*lcuclient.Event)) error {
-	return ws.client.SubscribeToAll(handler)
+func (ws *WebSocketClient) SubscribeToAll(handler func(*Event)) error {
+	return ws.Subscribe(allEventsKey, handler, EventTypeCreate, EventTypeUpdate, EventTypeDelete)
 }
 
-// Start is a no-op since lcu-gopher handles WebSocket automatically after Connect().
+// Start dials the LCU WebSocket and starts dispatching events.
 func (ws *WebSocketClient) Start() error {
-	// WebSocket is already connected when Client.Connect() is called
+	dialer := websocket.Dialer{
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: true, // LCU uses a self-signed certificate
+		},
+		Subprotocols:     []string{"wamp"},
+		HandshakeTimeout: requestTimeout,
+	}
+
+	headers := http.Header{}
+	headers.Set("Authorization", "Basic "+base64Encode("riot:"+ws.client.info.AuthToken))
+
+	conn, _, err := dialer.Dial("wss://"+ws.client.address()+"/", headers)
+	if err != nil {
+		return fmt.Errorf("failed to connect websocket: %w", err)
+	}
+
+	if err := conn.WriteJSON([]interface{}{wampSubscribe, jsonAPIEventTopic}); err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to subscribe to %s: %w", jsonAPIEventTopic, err)
+	}
+
+	done := make(chan struct{})
+
+	ws.mu.Lock()
+	ws.conn = conn
+	ws.done = done
+	ws.mu.Unlock()
+
+	go ws.listen(conn, done)
 	return nil
 }
 
-// Stop disconnects the WebSocket (disconnects the entire client).
+// Done returns a channel that is closed when the connection started by Start drops.
+func (ws *WebSocketClient) Done() <-chan struct{} {
+	ws.mu.RLock()
+	defer ws.mu.RUnlock()
+	return ws.done
+}
+
+// Stop closes the WebSocket connection.
 func (ws *WebSocketClient) Stop() {
-	ws.client.Disconnect()
+	ws.mu.Lock()
+	conn := ws.conn
+	ws.conn = nil
+	ws.mu.Unlock()
+
+	if conn != nil {
+		conn.Close()
+	}
+}
+
+// listen reads messages until the connection closes.
+func (ws *WebSocketClient) listen(conn *websocket.Conn, done chan struct{}) {
+	defer close(done)
+
+	for {
+		var message []json.RawMessage
+		if err := conn.ReadJSON(&message); err != nil {
+			return
+		}
+
+		if event := parseEventMessage(message); event != nil {
+			ws.dispatch(event)
+		}
+	}
+}
+
+// dispatch calls the handlers registered for the event URI and for all events.
+func (ws *WebSocketClient) dispatch(event *Event) {
+	ws.mu.RLock()
+	handlers := append([]func(*Event){}, ws.handlers[event.URI]...)
+	handlers = append(handlers, ws.handlers[allEventsKey]...)
+	ws.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
+
+// parseEventMessage decodes a WAMP event message, returning nil for anything else.
+func parseEventMessage(message []json.RawMessage) *Event {
+	if len(message) < 3 {
+		return nil
+	}
+
+	var opcode int
+	if err := json.Unmarshal(message[0], &opcode); err != nil || opcode != wampEvent {
+		return nil
+	}
+
+	var event Event
+	if err := json.Unmarshal(message[2], &event); err != nil {
+		return nil
+	}
+
+	return &event
 }
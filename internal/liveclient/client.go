@@ -0,0 +1,92 @@
+// Package liveclient reads the Live Client Data API that the game serves on
+// https://127.0.0.1:2999 while a match is running, and turns it into snapshots
+// for a live scoreboard.
+package liveclient
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// defaultBaseURL is the Live Client Data API of a game running on this machine.
+const defaultBaseURL = "https://127.0.0.1:2999/liveclientdata"
+
+// requestTimeout bounds every request; the API is local and answers quickly.
+const requestTimeout = 2 * time.Second
+
+// APIError is an error response from the Live Client Data API. The API answers 404
+// while the game is still loading.
+type APIError struct {
+	Status   int
+	Body     string
+	Endpoint string
+}
+
+// Error implements error.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("live client api error: %d %s - %s", e.Status, http.StatusText(e.Status), e.Body)
+}
+
+// StatusCode returns the HTTP status of the response.
+func (e *APIError) StatusCode() int {
+	return e.Status
+}
+
+// Client is a Live Client Data API client.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// NewClient creates a client for the game running on this machine.
+func NewClient() *Client {
+	return &Client{
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: true, // the game uses a self-signed certificate
+				},
+			},
+		},
+		baseURL: defaultBaseURL,
+	}
+}
+
+// GetAllGameData returns the active player, all players, the event feed and the game state.
+func (c *Client) GetAllGameData() (*AllGameData, error) {
+	var data AllGameData
+	if err := c.get("/allgamedata", &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// GetEvents returns the event feed.
+func (c *Client) GetEvents() ([]Event, error) {
+	var feed EventFeed
+	if err := c.get("/eventdata", &feed); err != nil {
+		return nil, err
+	}
+	return feed.Events, nil
+}
+
+// get performs a GET request and decodes the response into target.
+func (c *Client) get(endpoint string, target interface{}) error {
+	resp, err := c.httpClient.Get(c.baseURL + endpoint)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return &APIError{Status: resp.StatusCode, Body: string(body), Endpoint: endpoint}
+	}
+
+	return json.NewDecoder(resp.Body).Decode(target)
+}
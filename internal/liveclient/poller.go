@@ -0,0 +1,114 @@
+package liveclient
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultPollInterval is how often the game is polled while it runs.
+const DefaultPollInterval = time.Second
+
+// Poller polls the Live Client Data API while a game is running and reports every snapshot.
+// Failed polls (e.g. during the loading screen) are skipped until the API answers.
+type Poller struct {
+	client   *Client
+	interval time.Duration
+	onUpdate func(snapshot *Snapshot)
+	latest   *Snapshot
+	mu       sync.RWMutex
+	stop     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewPoller creates a poller that calls onUpdate with each new snapshot.
+func NewPoller(client *Client, interval time.Duration, onUpdate func(snapshot *Snapshot)) *Poller {
+	return &Poller{
+		client:   client,
+		interval: interval,
+		onUpdate: onUpdate,
+	}
+}
+
+// Start starts polling. It does nothing if the poller is already running.
+func (p *Poller) Start() {
+	p.mu.Lock()
+	if p.stop != nil {
+		p.mu.Unlock()
+		return
+	}
+	p.stop = make(chan struct{})
+	stop := p.stop
+	p.mu.Unlock()
+
+	p.wg.Add(1)
+	go p.run(stop)
+}
+
+// Stop stops polling and forgets the last snapshot.
+func (p *Poller) Stop() {
+	p.mu.Lock()
+	stop := p.stop
+	p.stop = nil
+	p.mu.Unlock()
+
+	if stop == nil {
+		return
+	}
+
+	close(stop)
+	p.wg.Wait()
+
+	p.mu.Lock()
+	p.latest = nil
+	p.mu.Unlock()
+}
+
+// Running reports whether the poller is running.
+func (p *Poller) Running() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.stop != nil
+}
+
+// Latest returns the most recent snapshot, or nil if none was taken since the poller started.
+func (p *Poller) Latest() *Snapshot {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.latest
+}
+
+// run polls until stop is closed.
+func (p *Poller) run(stop chan struct{}) {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.poll()
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll takes a snapshot and reports it.
+func (p *Poller) poll() {
+	data, err := p.client.GetAllGameData()
+	if err != nil {
+		return
+	}
+
+	snapshot := NewSnapshot(data)
+
+	p.mu.Lock()
+	p.latest = snapshot
+	p.mu.Unlock()
+
+	if p.onUpdate != nil {
+		p.onUpdate(snapshot)
+	}
+}
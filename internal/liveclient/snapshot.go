@@ -0,0 +1,131 @@
+package liveclient
+
+import "strings"
+
+// Snapshot is the scoreboard of a live game, built from one /allgamedata response.
+type Snapshot struct {
+	GameTime     float64        `json:"gameTime"` // seconds since the game started
+	GameMode     string         `json:"gameMode"`
+	ActivePlayer *ActivePlayer  `json:"activePlayer,omitempty"` // nil when spectating
+	ActiveTeam   string         `json:"activeTeam,omitempty"`   // team of the active player
+	Teams        []*TeamSummary `json:"teams"`                  // ORDER, then CHAOS
+	Players      []Player       `json:"players"`
+	GoldDiff     int            `json:"goldDiff"` // ORDER minus CHAOS item gold, an estimate
+	Events       []Event        `json:"events"`   // oldest first
+}
+
+// TeamSummary totals a team's scores and objectives.
+type TeamSummary struct {
+	Team       string `json:"team"`
+	Kills      int    `json:"kills"`
+	Deaths     int    `json:"deaths"`
+	Assists    int    `json:"assists"`
+	CreepScore int    `json:"creepScore"`
+	ItemGold   int    `json:"itemGold"` // value of the items held; other players' gold is not exposed
+	Turrets    int    `json:"turrets"`  // enemy turrets destroyed
+	Inhibitors int    `json:"inhibitors"`
+	Dragons    int    `json:"dragons"`
+	Heralds    int    `json:"heralds"`
+	Barons     int    `json:"barons"`
+}
+
+// NewSnapshot builds a snapshot from a game data response.
+func NewSnapshot(data *AllGameData) *Snapshot {
+	snapshot := &Snapshot{
+		GameTime:     data.GameData.GameTime,
+		GameMode:     data.GameData.GameMode,
+		ActivePlayer: data.ActivePlayer,
+		Players:      data.AllPlayers,
+		Events:       data.Events.Events,
+	}
+
+	teams := map[string]*TeamSummary{
+		TeamOrder: {Team: TeamOrder},
+		TeamChaos: {Team: TeamChaos},
+	}
+	playerTeams := make(map[string]string, len(data.AllPlayers)*3)
+
+	for i := range data.AllPlayers {
+		player := &data.AllPlayers[i]
+		for _, name := range []string{player.RiotID, player.RiotIDGameName, player.SummonerName} {
+			if name != "" {
+				playerTeams[name] = player.Team
+			}
+		}
+		if data.ActivePlayer != nil && (player.RiotID == data.ActivePlayer.RiotID || player.SummonerName == data.ActivePlayer.SummonerName) {
+			snapshot.ActiveTeam = player.Team
+		}
+
+		team, ok := teams[player.Team]
+		if !ok {
+			continue
+		}
+		team.Kills += player.Scores.Kills
+		team.Deaths += player.Scores.Deaths
+		team.Assists += player.Scores.Assists
+		team.CreepScore += player.Scores.CreepScore
+		team.ItemGold += player.ItemGold()
+	}
+
+	for _, event := range data.Events.Events {
+		switch event.EventName {
+		case EventTurretKilled:
+			if team := teams[opponent(structureTeam(event.Turret))]; team != nil {
+				team.Turrets++
+			}
+		case EventInhibKilled:
+			if team := teams[opponent(structureTeam(event.Inhib))]; team != nil {
+				team.Inhibitors++
+			}
+		case EventDragonKill:
+			if team := teams[playerTeams[event.KillerName]]; team != nil {
+				team.Dragons++
+			}
+		case EventHeraldKill:
+			if team := teams[playerTeams[event.KillerName]]; team != nil {
+				team.Heralds++
+			}
+		case EventBaronKill:
+			if team := teams[playerTeams[event.KillerName]]; team != nil {
+				team.Barons++
+			}
+		}
+	}
+
+	snapshot.Teams = []*TeamSummary{teams[TeamOrder], teams[TeamChaos]}
+	snapshot.GoldDiff = teams[TeamOrder].ItemGold - teams[TeamChaos].ItemGold
+	return snapshot
+}
+
+// ItemGold returns the value of a player's items.
+func (p *Player) ItemGold() int {
+	gold := 0
+	for _, item := range p.Items {
+		gold += item.Price * max(item.Count, 1)
+	}
+	return gold
+}
+
+// structureTeam returns the team owning a turret or inhibitor, e.g. "Turret_T1_L_03_A" is ORDER's.
+func structureTeam(name string) string {
+	switch {
+	case strings.Contains(name, "_T1_"):
+		return TeamOrder
+	case strings.Contains(name, "_T2_"):
+		return TeamChaos
+	default:
+		return ""
+	}
+}
+
+// opponent returns the other team.
+func opponent(team string) string {
+	switch team {
+	case TeamOrder:
+		return TeamChaos
+	case TeamChaos:
+		return TeamOrder
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,142 @@
+package liveclient
+
+// Teams as named by the Live Client Data API
+const (
+	TeamOrder = "ORDER" // blue side
+	TeamChaos = "CHAOS" // red side
+)
+
+// Event names in the event feed
+const (
+	EventGameStart       = "GameStart"
+	EventMinionsSpawning = "MinionsSpawning"
+	EventFirstBlood      = "FirstBlood"
+	EventChampionKill    = "ChampionKill"
+	EventMultikill       = "Multikill"
+	EventAce             = "Ace"
+	EventFirstBrick      = "FirstBrick"
+	EventTurretKilled    = "TurretKilled"
+	EventInhibKilled     = "InhibKilled"
+	EventInhibRespawned  = "InhibRespawned"
+	EventDragonKill      = "DragonKill"
+	EventHeraldKill      = "HeraldKill"
+	EventBaronKill       = "BaronKill"
+	EventGameEnd         = "GameEnd"
+)
+
+// AllGameData is the response of /allgamedata.
+type AllGameData struct {
+	ActivePlayer *ActivePlayer `json:"activePlayer"` // nil when spectating
+	AllPlayers   []Player      `json:"allPlayers"`
+	Events       EventFeed     `json:"events"`
+	GameData     GameData      `json:"gameData"`
+}
+
+// GameData describes the running game.
+type GameData struct {
+	GameMode   string  `json:"gameMode"`
+	GameTime   float64 `json:"gameTime"` // seconds since the game started
+	MapName    string  `json:"mapName"`
+	MapNumber  int     `json:"mapNumber"`
+	MapTerrain string  `json:"mapTerrain"`
+}
+
+// ActivePlayer is the player on this machine.
+type ActivePlayer struct {
+	RiotID        string        `json:"riotId"`
+	SummonerName  string        `json:"summonerName"`
+	Level         int           `json:"level"`
+	CurrentGold   float64       `json:"currentGold"`
+	ChampionStats ChampionStats `json:"championStats"`
+}
+
+// ChampionStats are the active player's current champion stats.
+type ChampionStats struct {
+	AbilityPower  float64 `json:"abilityPower"`
+	AttackDamage  float64 `json:"attackDamage"`
+	AttackSpeed   float64 `json:"attackSpeed"`
+	Armor         float64 `json:"armor"`
+	MagicResist   float64 `json:"magicResist"`
+	CurrentHealth float64 `json:"currentHealth"`
+	MaxHealth     float64 `json:"maxHealth"`
+	MoveSpeed     float64 `json:"moveSpeed"`
+	ResourceValue float64 `json:"resourceValue"`
+	ResourceMax   float64 `json:"resourceMax"`
+	ResourceType  string  `json:"resourceType"`
+	AbilityHaste  float64 `json:"abilityHaste"`
+	CritChance    float64 `json:"critChance"`
+}
+
+// Player is one of the ten players in the game.
+type Player struct {
+	RiotID          string         `json:"riotId"`
+	RiotIDGameName  string         `json:"riotIdGameName"`
+	RiotIDTagLine   string         `json:"riotIdTagLine"`
+	SummonerName    string         `json:"summonerName"`
+	ChampionName    string         `json:"championName"`
+	RawChampionName string         `json:"rawChampionName"`
+	Team            string         `json:"team"`     // TeamOrder or TeamChaos
+	Position        string         `json:"position"` // empty outside of matchmade games
+	Level           int            `json:"level"`
+	IsBot           bool           `json:"isBot"`
+	IsDead          bool           `json:"isDead"`
+	RespawnTimer    float64        `json:"respawnTimer"`
+	Items           []Item         `json:"items"`
+	Scores          Scores         `json:"scores"`
+	SummonerSpells  SummonerSpells `json:"summonerSpells"`
+}
+
+// Item is an item in a player's inventory.
+type Item struct {
+	ItemID      int    `json:"itemID"`
+	DisplayName string `json:"displayName"`
+	Count       int    `json:"count"`
+	Price       int    `json:"price"`
+	Slot        int    `json:"slot"`
+	Consumable  bool   `json:"consumable"`
+	CanUse      bool   `json:"canUse"`
+}
+
+// Scores are a player's scoreboard values.
+type Scores struct {
+	Kills      int     `json:"kills"`
+	Deaths     int     `json:"deaths"`
+	Assists    int     `json:"assists"`
+	CreepScore int     `json:"creepScore"`
+	WardScore  float64 `json:"wardScore"`
+}
+
+// SummonerSpells are a player's two summoner spells.
+type SummonerSpells struct {
+	SummonerSpellOne SummonerSpell `json:"summonerSpellOne"`
+	SummonerSpellTwo SummonerSpell `json:"summonerSpellTwo"`
+}
+
+// SummonerSpell is a summoner spell.
+type SummonerSpell struct {
+	DisplayName string `json:"displayName"`
+}
+
+// EventFeed is the response of /eventdata.
+type EventFeed struct {
+	Events []Event `json:"Events"`
+}
+
+// Event is an entry of the event feed. Which fields are set depends on the event name.
+type Event struct {
+	EventID    int      `json:"EventID"`
+	EventName  string   `json:"EventName"`
+	EventTime  float64  `json:"EventTime"` // seconds since the game started
+	KillerName string   `json:"KillerName,omitempty"`
+	VictimName string   `json:"VictimName,omitempty"`
+	Assisters  []string `json:"Assisters,omitempty"`
+	Recipient  string   `json:"Recipient,omitempty"`  // FirstBlood
+	KillStreak int      `json:"KillStreak,omitempty"` // Multikill
+	Acer       string   `json:"Acer,omitempty"`       // Ace
+	AcingTeam  string   `json:"AcingTeam,omitempty"`  // Ace
+	DragonType string   `json:"DragonType,omitempty"` // DragonKill
+	Stolen     string   `json:"Stolen,omitempty"`     // "True" or "False" on epic monster kills
+	Turret     string   `json:"TurretKilled,omitempty"`
+	Inhib      string   `json:"InhibKilled,omitempty"`
+	Result     string   `json:"Result,omitempty"` // GameEnd: "Win" or "Lose"
+}
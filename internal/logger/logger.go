@@ -3,16 +3,13 @@ package logger
 import (
 	"encoding/json"
 	"net/http"
-	"regexp"
-	"strconv"
+	"slices"
+	"strings"
 	"time"
-)
 
-const (
-	// minHTTPStatusCode is the minimum valid HTTP status code (100)
-	minHTTPStatusCode = 100
-	// maxHTTPStatusCode is the validation upper bound (600) - not a standard HTTP status code
-	maxHTTPStatusCode = 600
+	"lol-toolkit/internal/apierr"
+	"lol-toolkit/internal/retry"
+	"lol-toolkit/internal/secrets"
 )
 
 // APILogEntry represents a single API call for logging/telemetry.
@@ -26,6 +23,8 @@ type APILogEntry struct {
 	Headers    map[string]string `json:"headers,omitempty"`  // request headers
 	Response   string            `json:"response,omitempty"` // optional response body (JSON)
 	Error      string            `json:"error,omitempty"`
+	Attempt    int               `json:"attempt,omitempty"` // 1-based attempt number when retries are enabled
+	Cached     bool              `json:"cached,omitempty"`  // served from the response cache, no request was made
 }
 
 // apiLogger is an optional callback set by the app to receive API logs.
@@ -36,66 +35,88 @@ func SetAPILogger(logger func(entry APILogEntry)) {
 	apiLogger = logger
 }
 
+// sensitiveHeaders are request headers holding credentials. Their values are redacted
+// before an entry leaves the logger, since entries are shown in the Debug tab.
+var sensitiveHeaders = []string{"X-Riot-Token", "Authorization"}
+
 // logAPICall sends an API log entry to the registered logger if present.
 func logAPICall(entry APILogEntry) {
 	if apiLogger != nil {
+		entry.Headers = redactHeaders(entry.Headers)
 		apiLogger(entry)
 	}
 }
 
-// extractStatusCode tries to extract HTTP status code from an error message.
-// Returns the status code if found, otherwise returns http.StatusInternalServerError as fallback.
-func extractStatusCode(err error) int {
-	if err == nil {
-		return http.StatusInternalServerError
-	}
-
-	errMsg := err.Error()
-
-	// Try to match common patterns:
-	// - "status code: 404"
-	// - "404"
-	// - "unexpected status code: 404"
-	// - "HTTP 404"
-	patterns := []*regexp.Regexp{
-		regexp.MustCompile(`status code[:\s]+(\d{3})`),
-		regexp.MustCompile(`HTTP[:\s]+(\d{3})`),
-		regexp.MustCompile(`\b(\d{3})\b`), // Any 3-digit number
+// redactHeaders returns a copy of headers with credentials redacted.
+func redactHeaders(headers map[string]string) map[string]string {
+	if headers == nil {
+		return nil
 	}
 
-	for _, pattern := range patterns {
-		matches := pattern.FindStringSubmatch(errMsg)
-		if len(matches) >= 2 {
-			if code, parseErr := strconv.Atoi(matches[1]); parseErr == nil {
-				// Validate it's a valid HTTP status code (100-599)
-				if code >= minHTTPStatusCode && code < maxHTTPStatusCode {
-					return code
-				}
-			}
+	redacted := make(map[string]string, len(headers))
+	for key, value := range headers {
+		if slices.ContainsFunc(sensitiveHeaders, func(h string) bool { return strings.EqualFold(h, key) }) {
+			value = secrets.Redact(value)
 		}
+		redacted[key] = value
 	}
-
-	// Fallback to http.StatusInternalServerError if no status code found
-	return http.StatusInternalServerError
+	return redacted
 }
 
 // LoggedCall wraps an API call with automatic timing and logging.
 // It executes the provided function, measures duration, and logs the result.
 // Uses generics to maintain type safety - no type assertions needed.
 func LoggedCall[T any](apiType, method, endpoint string, statusCode int, headers map[string]string, fn func() (T, error)) (T, error) {
-	start := time.Now()
-	result, err := fn()
-	duration := time.Since(start)
+	return LoggedRetryCall(apiType, retry.NoRetry, method, endpoint, statusCode, headers, fn)
+}
 
-	var response string
-	if err == nil {
-		// Try to marshal the result to JSON
-		if jsonData, marshalErr := json.MarshalIndent(result, "", "  "); marshalErr == nil {
-			response = string(jsonData)
+// LoggedRetryCall is LoggedCall with retries. Every attempt is logged with its attempt number.
+func LoggedRetryCall[T any](apiType string, policy retry.Policy, method, endpoint string, statusCode int, headers map[string]string, fn func() (T, error)) (T, error) {
+	var result T
+	err := retry.Do(policy, func(attempt int) (int, error) {
+		start := time.Now()
+		var err error
+		result, err = fn()
+		duration := time.Since(start)
+
+		var response string
+		if err == nil {
+			// Try to marshal the result to JSON
+			if jsonData, marshalErr := json.MarshalIndent(result, "", "  "); marshalErr == nil {
+				response = string(jsonData)
+			}
+		}
+
+		// Build log entry
+		entry := APILogEntry{
+			Type:       apiType,
+			Method:     method,
+			Endpoint:   endpoint,
+			StatusCode: statusCode,
+			Duration:   duration.Milliseconds(),
+			Headers:    headers,
+			Response:   response,
+		}
+		if policy.MaxAttempts > 1 {
+			entry.Attempt = attempt
+		}
+
+		status := apierr.StatusOf(err)
+		if err != nil {
+			entry.StatusCode = status
+			entry.Error = err.Error()
+			entry.Response = "" // Clear response on error
 		}
-	}
 
-	// Build log entry
+		logAPICall(entry)
+		return status, err
+	})
+	return result, err
+}
+
+// LogAttempt logs one attempt of a retried API call.
+// If statusCode is 0 and err is set, the status code is taken from the error.
+func LogAttempt(apiType, method, endpoint string, attempt, statusCode int, duration time.Duration, headers map[string]string, response string, err error) {
 	entry := APILogEntry{
 		Type:       apiType,
 		Method:     method,
@@ -104,17 +125,28 @@ func LoggedCall[T any](apiType, method, endpoint string, statusCode int, headers
 		Duration:   duration.Milliseconds(),
 		Headers:    headers,
 		Response:   response,
+		Attempt:    attempt,
 	}
-
 	if err != nil {
-		// Try to extract status code from error, fallback to http.StatusInternalServerError
-		entry.StatusCode = extractStatusCode(err)
+		if entry.StatusCode == 0 {
+			entry.StatusCode = apierr.StatusOf(err)
+		}
 		entry.Error = err.Error()
-		entry.Response = "" // Clear response on error
 	}
-
 	logAPICall(entry)
-	return result, err
+}
+
+// LogCacheHit logs a call that was answered from the response cache.
+func LogCacheHit(apiType, method, endpoint string, headers map[string]string, response string) {
+	logAPICall(APILogEntry{
+		Type:       apiType,
+		Method:     method,
+		Endpoint:   endpoint,
+		StatusCode: http.StatusOK,
+		Headers:    headers,
+		Response:   response,
+		Cached:     true,
+	})
 }
 
 // LogSuccess logs a successful API call.
@@ -131,18 +163,15 @@ func LogSuccess(apiType, method, endpoint string, statusCode int, duration time.
 }
 
 // LogError logs a failed API call.
-// If statusCode is 0, it will try to extract status code from the error, otherwise uses http.StatusInternalServerError as fallback.
+// If statusCode is not given, it is taken from the error (0 if no response was received).
 func LogError(apiType, method, endpoint string, duration time.Duration, headers map[string]string, err error, statusCode ...int) {
 	if err == nil {
 		return
 	}
 
-	code := http.StatusInternalServerError
+	code := apierr.StatusOf(err)
 	if len(statusCode) > 0 && statusCode[0] > 0 {
 		code = statusCode[0]
-	} else {
-		// Try to extract from error message
-		code = extractStatusCode(err)
 	}
 
 	logAPICall(APILogEntry{
@@ -0,0 +1,159 @@
+package lol
+
+import (
+	"strconv"
+)
+
+// Minutes at which the lane gold difference is reported
+const (
+	laneDiffEarly = 10
+	laneDiffMid   = 15
+)
+
+// MatchAnalysis summarizes a match timeline for review
+type MatchAnalysis struct {
+	MatchID      string                 `json:"matchId"`
+	Participants []*ParticipantAnalysis `json:"participants"`
+	Kills        []*TimelineEvent       `json:"kills"`
+	Objectives   []*TimelineEvent       `json:"objectives"` // epic monsters and buildings
+}
+
+// ParticipantAnalysis holds one participant's curves and early game stats
+type ParticipantAnalysis struct {
+	ParticipantID int    `json:"participantId"`
+	PUUID         string `json:"puuid"`
+	ChampionID    int    `json:"championId"`
+	TeamID        int    `json:"teamId"`
+	Position      string `json:"position"`
+	// Gold, XP and CS are sampled once per timeline frame (every minute)
+	Gold []int `json:"gold"`
+	XP   []int `json:"xp"`
+	CS   []int `json:"cs"`
+	// OpponentID is the lane opponent's participant ID, or 0 if there is none (e.g. ARAM)
+	OpponentID   int  `json:"opponentId"`
+	GoldDiffAt10 *int `json:"goldDiffAt10"` // nil if the game or lane did not last that long
+	GoldDiffAt15 *int `json:"goldDiffAt15"`
+	// FirstBlood and FirstTower are true if the participant took or assisted them
+	FirstBlood bool `json:"firstBlood"`
+	FirstTower bool `json:"firstTower"`
+}
+
+// AnalyzeMatch computes gold, XP and CS curves, lane gold differences and
+// early objective participation from a match and its timeline.
+func AnalyzeMatch(match *MatchInfo, timeline *MatchTimeline) *MatchAnalysis {
+	analysis := &MatchAnalysis{
+		MatchID:      match.MatchID,
+		Participants: make([]*ParticipantAnalysis, 0, len(timeline.Participants)),
+		Kills:        []*TimelineEvent{},
+		Objectives:   []*TimelineEvent{},
+	}
+
+	byPUUID := make(map[string]*MatchParticipant, len(match.Participants))
+	for _, p := range match.Participants {
+		byPUUID[p.PUUID] = p
+	}
+
+	byID := make(map[int]*ParticipantAnalysis, len(timeline.Participants))
+	for _, tp := range timeline.Participants {
+		pa := &ParticipantAnalysis{
+			ParticipantID: tp.ParticipantID,
+			PUUID:         tp.PUUID,
+			Gold:          make([]int, 0, len(timeline.Frames)),
+			XP:            make([]int, 0, len(timeline.Frames)),
+			CS:            make([]int, 0, len(timeline.Frames)),
+		}
+		if p, ok := byPUUID[tp.PUUID]; ok {
+			pa.ChampionID = p.ChampionID
+			pa.TeamID = p.TeamID
+			pa.Position = p.Position
+		}
+		byID[tp.ParticipantID] = pa
+		analysis.Participants = append(analysis.Participants, pa)
+	}
+
+	for _, frame := range timeline.Frames {
+		for _, pa := range analysis.Participants {
+			pf := frame.ParticipantFrames[strconv.Itoa(pa.ParticipantID)]
+			if pf == nil {
+				pf = &ParticipantFrame{}
+			}
+			pa.Gold = append(pa.Gold, pf.TotalGold)
+			pa.XP = append(pa.XP, pf.XP)
+			pa.CS = append(pa.CS, pf.MinionsKilled+pf.JungleMinionsKilled)
+		}
+
+		for _, event := range frame.Events {
+			switch event.Type {
+			case EventChampionKill:
+				if len(analysis.Kills) == 0 {
+					markParticipation(byID, event, func(pa *ParticipantAnalysis) { pa.FirstBlood = true })
+				}
+				analysis.Kills = append(analysis.Kills, event)
+			case EventBuildingKill, EventEliteMonsterKill:
+				if event.BuildingType == buildingTower && !hasTowerKill(analysis.Objectives) {
+					markParticipation(byID, event, func(pa *ParticipantAnalysis) { pa.FirstTower = true })
+				}
+				analysis.Objectives = append(analysis.Objectives, event)
+			}
+		}
+	}
+
+	for _, pa := range analysis.Participants {
+		opponent := laneOpponent(analysis.Participants, pa)
+		if opponent == nil {
+			continue
+		}
+		pa.OpponentID = opponent.ParticipantID
+		pa.GoldDiffAt10 = goldDiffAt(pa, opponent, laneDiffEarly, timeline.FrameInterval)
+		pa.GoldDiffAt15 = goldDiffAt(pa, opponent, laneDiffMid, timeline.FrameInterval)
+	}
+
+	return analysis
+}
+
+// markParticipation applies mark to the killer and assisting participants of an event.
+func markParticipation(byID map[int]*ParticipantAnalysis, event *TimelineEvent, mark func(*ParticipantAnalysis)) {
+	for _, id := range append([]int{event.KillerID}, event.AssistingParticipantIDs...) {
+		if pa, ok := byID[id]; ok {
+			mark(pa)
+		}
+	}
+}
+
+// hasTowerKill returns true if a tower was already destroyed.
+func hasTowerKill(objectives []*TimelineEvent) bool {
+	for _, event := range objectives {
+		if event.BuildingType == buildingTower {
+			return true
+		}
+	}
+	return false
+}
+
+// laneOpponent returns the enemy participant playing the same position, or nil.
+func laneOpponent(participants []*ParticipantAnalysis, pa *ParticipantAnalysis) *ParticipantAnalysis {
+	if pa.Position == "" {
+		return nil
+	}
+	for _, other := range participants {
+		if other.TeamID != pa.TeamID && other.Position == pa.Position {
+			return other
+		}
+	}
+	return nil
+}
+
+// goldDiffAt returns the gold difference to the opponent at the given minute, or nil if there is no frame for it.
+func goldDiffAt(pa, opponent *ParticipantAnalysis, minute, frameInterval int) *int {
+	if frameInterval <= 0 {
+		return nil
+	}
+
+	frame := minute * 60000 / frameInterval
+	if frame >= len(pa.Gold) || frame >= len(opponent.Gold) {
+		return nil
+	}
+
+	diff := pa.Gold[frame] - opponent.Gold[frame]
+	return &diff
+}
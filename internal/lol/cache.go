@@ -0,0 +1,90 @@
+package lol
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"lol-toolkit/internal/cache"
+	"lol-toolkit/internal/logger"
+)
+
+// defaultCacheSize is the number of responses a client keeps in memory when no shared cache is set.
+const defaultCacheSize = 1000
+
+// cacheForever keeps a response until it is evicted; used for data that never changes.
+const cacheForever time.Duration = 0
+
+// cacheTTLs maps an endpoint to how long its responses are cached.
+// Endpoints that are not listed are never cached.
+var cacheTTLs = map[string]time.Duration{
+	"account/by-riot-id":     6 * time.Hour,
+	"account/by-puuid":       6 * time.Hour,
+	"summoner/by-puuid":      30 * time.Minute,
+	"summoner/by-id":         30 * time.Minute,
+	"league/by-summoner":     time.Minute,
+	"league/challenger":      5 * time.Minute,
+	"league/grandmaster":     5 * time.Minute,
+	"league/master":          5 * time.Minute,
+	"league/entries":         5 * time.Minute,
+	"champion-mastery/get":   10 * time.Minute,
+	"champion-mastery/list":  10 * time.Minute,
+	"champion-mastery/total": 10 * time.Minute,
+	"match/by-id":            cacheForever, // finished matches never change
+	"match/timeline":         cacheForever,
+}
+
+// SetCache replaces the client's response cache, e.g. with one shared between clients.
+func (c *Client) SetCache(responses *cache.Cache) {
+	c.cache = responses
+}
+
+// cachedCall is LoggedCall behind the response cache. The result is cached under the
+// region, endpoint and params for the endpoint's TTL. Cache hits are logged as such.
+func cachedCall[T any](c *Client, endpoint string, params []string, fn func() (T, error)) (T, error) {
+	return callWithCache(c, endpoint, params, false, fn)
+}
+
+// callWithCache implements cachedCall. With refresh set the cache lookup is skipped: the API
+// is always called and the cached response replaced, for callers waiting for a value to change.
+func callWithCache[T any](c *Client, endpoint string, params []string, refresh bool, fn func() (T, error)) (T, error) {
+	ttl, cacheable := cacheTTLs[endpoint]
+	if !cacheable {
+		return riotCall(c, endpoint, fn)
+	}
+
+	key := string(c.region) + "|" + endpoint + "|" + strings.Join(params, "|")
+	if data, ok := c.cache.Get(key); ok && !refresh {
+		var result T
+		if err := json.Unmarshal(data, &result); err == nil {
+			logger.LogCacheHit(apiType, "GET", endpoint, c.getHeaders(), indentJSON(data))
+			return result, nil
+		}
+	}
+
+	result, err := riotCall(c, endpoint, fn)
+	if err != nil {
+		return result, err
+	}
+
+	if data, err := json.Marshal(result); err == nil && string(data) != "null" {
+		c.cache.Set(key, data, ttl)
+	}
+	return result, nil
+}
+
+// riotCall is LoggedCall for a GET with the client's headers.
+func riotCall[T any](c *Client, endpoint string, fn func() (T, error)) (T, error) {
+	return LoggedCall("GET", endpoint, http.StatusOK, c.getHeaders(), fn)
+}
+
+// indentJSON formats cached JSON the way LoggedCall formats live responses.
+func indentJSON(data []byte) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return string(data)
+	}
+	return buf.String()
+}
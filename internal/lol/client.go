@@ -3,39 +3,33 @@ package lol
 import (
 	"encoding/json"
 	"fmt"
+	"net"
+	"net/http"
+	"time"
 
 	"github.com/KnutZuidema/golio"
 	"github.com/KnutZuidema/golio/api"
+
+	"lol-toolkit/internal/cache"
 )
 
 // Default region when not specified.
 const DefaultRegion = "vn2"
 
+// requestTimeout bounds the network exchange of a single Riot API request: connecting,
+// the TLS handshake and waiting for the response headers. Waiting for the rate limiter is
+// not bounded, so calls queue until the rate limit window allows them.
+const requestTimeout = 10 * time.Second
+
 // Client wraps the golio client.
 type Client struct {
-	golio  *golio.Client
-	region api.Region
-	apiKey string // stored for logging headers
-}
-
-// regionMap maps region codes to golio Region constants.
-var regionMap = map[string]api.Region{
-	"br1":  api.RegionBrasil,
-	"eun1": api.RegionEuropeNorthEast,
-	"euw1": api.RegionEuropeWest,
-	"jp1":  api.RegionJapan,
-	"kr":   api.RegionKorea,
-	"la1":  api.RegionLatinAmericaNorth,
-	"la2":  api.RegionLatinAmericaSouth,
-	"na1":  api.RegionNorthAmerica,
-	"oc1":  api.RegionOceania,
-	"tr1":  api.RegionTurkey,
-	"ru":   api.RegionRussia,
-	"me1":  api.RegionMiddleEast,
-	"sea":  api.RegionSouthEastAsia,
-	"sg2":  api.RegionSouthEastAsia,
-	"tw2":  api.RegionTaiwan,
-	"vn2":  api.RegionVietnam,
+	golio    *golio.Client
+	http     *http.Client // shared with golio, used directly for endpoints golio gets wrong
+	limiter  *RateLimiter
+	cache    *cache.Cache
+	region   api.Region
+	platform Platform
+	apiKey   string // stored for logging headers
 }
 
 // NewClient creates a new LoL API client.
@@ -44,22 +38,37 @@ func NewClient(apiKey, region string) (*Client, error) {
 		return nil, fmt.Errorf("api key is required")
 	}
 
-	r := parseRegion(region)
-	client := golio.NewClient(apiKey, golio.WithRegion(r))
+	platform, err := LookupPlatform(region)
+	if err != nil {
+		return nil, err
+	}
+
+	r := platform.golioRegion()
+	limiter := NewRateLimiter()
+	httpClient := &http.Client{
+		Transport: limiter.Transport(newTransport()),
+	}
+	client := golio.NewClient(apiKey, golio.WithRegion(r), golio.WithClient(httpClient))
 
 	return &Client{
-		golio:  client,
-		region: r,
-		apiKey: apiKey,
+		golio:    client,
+		http:     httpClient,
+		limiter:  limiter,
+		cache:    cache.New(defaultCacheSize, nil),
+		region:   r,
+		platform: platform,
+		apiKey:   apiKey,
 	}, nil
 }
 
-// parseRegion converts region string to api.Region.
-func parseRegion(region string) api.Region {
-	if r, ok := regionMap[region]; ok {
-		return r
-	}
-	return api.RegionVietnam
+// newTransport returns an HTTP transport whose timeouts only cover the network exchange.
+// http.Client.Timeout cannot be used, as its deadline would also cover the rate limiter wait.
+func newTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{Timeout: requestTimeout, KeepAlive: 30 * time.Second}).DialContext
+	transport.TLSHandshakeTimeout = requestTimeout
+	transport.ResponseHeaderTimeout = requestTimeout
+	return transport
 }
 
 // GetGolio returns the underlying golio client.
@@ -72,6 +81,21 @@ func (c *Client) GetRegion() api.Region {
 	return c.region
 }
 
+// GetPlatform returns the configured platform and its routing clusters.
+func (c *Client) GetPlatform() Platform {
+	return c.platform
+}
+
+// GetRoute returns the regional route (americas, europe, asia, sea) for the configured platform.
+func (c *Client) GetRoute() api.Route {
+	return api.Route(c.platform.Cluster)
+}
+
+// GetRateLimits returns the current rate limit usage per host and endpoint.
+func (c *Client) GetRateLimits() []RateLimitState {
+	return c.limiter.State()
+}
+
 // getHeaders returns the standard headers for Riot API requests.
 func (c *Client) getHeaders() map[string]string {
 	headers := make(map[string]string)
@@ -82,14 +106,36 @@ func (c *Client) getHeaders() map[string]string {
 	return headers
 }
 
-// marshalResponse converts a response object to JSON string for logging.
-func (c *Client) marshalResponse(data interface{}) string {
-	if data == nil {
-		return ""
+// getRegional performs a GET request against the cluster serving the endpoint and decodes the response into target.
+func (c *Client) getRegional(endpoint string, target interface{}) error {
+	return c.get(c.platform.clusterFor(endpoint), endpoint, target)
+}
+
+// getPlatform performs a GET request against the platform route and decodes the response into target.
+func (c *Client) getPlatform(endpoint string, target interface{}) error {
+	return c.get(string(c.region), endpoint, target)
+}
+
+// get performs a GET request against a Riot API host and decodes the response into target.
+func (c *Client) get(host, endpoint string, target interface{}) error {
+	url := fmt.Sprintf("https://%s.api.riotgames.com%s", host, endpoint)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	for key, value := range c.getHeaders() {
+		req.Header.Set(key, value)
 	}
-	jsonData, err := json.MarshalIndent(data, "", "  ")
+
+	resp, err := c.http.Do(req)
 	if err != nil {
-		return fmt.Sprintf("(failed to marshal: %v)", err)
+		return err
 	}
-	return string(jsonData)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return newRiotError(resp)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(target)
 }
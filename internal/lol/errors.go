@@ -0,0 +1,71 @@
+package lol
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/KnutZuidema/golio/api"
+)
+
+// RiotError is an error response from the Riot API.
+type RiotError struct {
+	Status     int
+	Message    string
+	RetryAfter time.Duration // wait before retrying a 429, from Retry-After or the rate limiter
+}
+
+// Error implements error.
+func (e *RiotError) Error() string {
+	return fmt.Sprintf("riot api error: %d %s", e.Status, e.Message)
+}
+
+// StatusCode returns the HTTP status of the response.
+func (e *RiotError) StatusCode() int {
+	return e.Status
+}
+
+// Is matches a RiotError or golio api.Error with the same status,
+// so errors.Is(err, api.ErrNotFound) works on converted errors.
+func (e *RiotError) Is(target error) bool {
+	switch t := target.(type) {
+	case *RiotError:
+		return t.Status == e.Status
+	case api.Error:
+		return t.StatusCode == e.Status
+	}
+	return false
+}
+
+// newRiotError builds a RiotError from an error response.
+func newRiotError(resp *http.Response) *RiotError {
+	err := &RiotError{Status: resp.StatusCode, Message: statusMessage(resp.StatusCode)}
+	if seconds, convErr := strconv.Atoi(resp.Header.Get("Retry-After")); convErr == nil {
+		err.RetryAfter = time.Duration(seconds) * time.Second
+	}
+	return err
+}
+
+// toRiotError converts golio's api.Error into a *RiotError, and unwraps the *RiotError the
+// rate limit transport returns from the *url.Error of http.Client. Other errors are returned unchanged.
+func toRiotError(err error) error {
+	var riotErr *RiotError
+	if errors.As(err, &riotErr) {
+		return riotErr
+	}
+	var apiErr api.Error
+	if errors.As(err, &apiErr) {
+		return &RiotError{Status: apiErr.StatusCode, Message: apiErr.Message}
+	}
+	return err
+}
+
+// statusMessage returns golio's description of a status, or the standard status text.
+func statusMessage(status int) string {
+	if apiErr, ok := api.StatusToError[status]; ok {
+		return apiErr.Message
+	}
+	return http.StatusText(status)
+}
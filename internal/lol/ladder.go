@@ -0,0 +1,140 @@
+package lol
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sync"
+
+	"lol-toolkit/internal/apierr"
+)
+
+// Ladder limits
+const (
+	ladderSize = 50 // entries returned, each needs a Riot ID lookup
+
+	// maxLadderPages bounds the entry pages read per division below Master. Entry pages are
+	// not sorted, so a division is read in full before positions are assigned; the
+	// largest divisions have around 100 pages of 205 entries.
+	maxLadderPages = 200
+)
+
+// Ladder is the top of a ranked tier for the frontend
+type Ladder struct {
+	Queue   string         `json:"queue"`
+	Tier    string         `json:"tier"`
+	Entries []*LadderEntry `json:"entries"` // best first
+	Partial bool           `json:"partial"` // a division had more pages than were read, so the entries are a sample
+}
+
+// LadderEntry is a ranked entry with its ladder position and Riot ID
+type LadderEntry struct {
+	Position int `json:"position"` // 1-based; 0 when the ladder is partial, as the unread pages may rank higher
+	RankedInfo
+	GameName string `json:"gameName"`
+	TagLine  string `json:"tagLine"`
+}
+
+// GetLadder fetches the best players of a tier, sorted by division and LP, with Riot IDs resolved.
+// Apex tiers are a single league; lower tiers are paged through division by division
+// until there are enough players to fill the ladder.
+func (c *Client) GetLadder(queueType, tier string) (*Ladder, error) {
+	if !slices.Contains(Tiers, tier) {
+		return nil, apierr.New(apierr.CodeBadRequest, fmt.Sprintf("unknown tier %q", tier))
+	}
+
+	ladder := &Ladder{Queue: queueType, Tier: tier}
+	var entries []*RankedInfo
+	if apexLeagues[tier] != "" {
+		league, err := c.getApexLeague(queueType, tier)
+		if err != nil {
+			return nil, err
+		}
+		entries = league.Entries
+		for _, e := range entries {
+			// entries of a league list leave out what the list has in common
+			e.QueueType = queueType
+			e.Tier = tier
+		}
+	} else {
+		var err error
+		entries, ladder.Partial, err = c.topLeagueEntries(queueType, tier)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sortLadder(entries)
+	if len(entries) > ladderSize {
+		entries = entries[:ladderSize]
+	}
+
+	ladder.Entries = make([]*LadderEntry, len(entries))
+	for i, e := range entries {
+		ladder.Entries[i] = &LadderEntry{RankedInfo: *e}
+		if !ladder.Partial {
+			ladder.Entries[i].Position = i + 1
+		}
+	}
+	c.resolveRiotIDs(ladder.Entries)
+
+	return ladder, nil
+}
+
+// topLeagueEntries reads the divisions of a tier from the highest down, stopping after the
+// division that fills the ladder. partial reports whether a division was cut off.
+func (c *Client) topLeagueEntries(queueType, tier string) (entries []*RankedInfo, partial bool, err error) {
+	for _, division := range Divisions {
+		for page := 1; ; page++ {
+			if page > maxLadderPages {
+				partial = true
+				break
+			}
+
+			pageEntries, err := c.GetLeagueEntries(queueType, tier, division, page)
+			if err != nil {
+				return nil, false, err
+			}
+			if len(pageEntries) == 0 {
+				break
+			}
+			entries = append(entries, pageEntries...)
+		}
+
+		if len(entries) >= ladderSize {
+			break
+		}
+	}
+	return entries, partial, nil
+}
+
+// sortLadder sorts entries best first: by division, then LP, then wins.
+func sortLadder(entries []*RankedInfo) {
+	slices.SortStableFunc(entries, func(a, b *RankedInfo) int {
+		return cmp.Or(
+			cmp.Compare(slices.Index(Divisions, a.Rank), slices.Index(Divisions, b.Rank)),
+			cmp.Compare(b.LeaguePoints, a.LeaguePoints),
+			cmp.Compare(b.Wins, a.Wins),
+		)
+	})
+}
+
+// resolveRiotIDs fills in the Riot ID of every entry through the cached account-v1 lookup.
+// Failed lookups leave it empty.
+func (c *Client) resolveRiotIDs(entries []*LadderEntry) {
+	var wg sync.WaitGroup
+	for _, entry := range entries {
+		if entry.PUUID == "" {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if account, err := c.getAccountByPUUID(entry.PUUID); err == nil {
+				entry.GameName = account.GameName
+				entry.TagLine = account.TagLine
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,86 @@
+package lol
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestSortLadder(t *testing.T) {
+	entries := []*RankedInfo{
+		{PUUID: "ii-high", Rank: "II", LeaguePoints: 99, Wins: 50},
+		{PUUID: "i-low", Rank: "I", LeaguePoints: 10, Wins: 40},
+		{PUUID: "iv", Rank: "IV", LeaguePoints: 100, Wins: 90},
+		{PUUID: "i-high-fewer-wins", Rank: "I", LeaguePoints: 75, Wins: 20},
+		{PUUID: "i-high-more-wins", Rank: "I", LeaguePoints: 75, Wins: 30},
+		{PUUID: "ii-low", Rank: "II", LeaguePoints: 0, Wins: 10},
+	}
+
+	sortLadder(entries)
+
+	got := make([]string, len(entries))
+	for i, e := range entries {
+		got[i] = e.PUUID
+	}
+	want := []string{"i-high-more-wins", "i-high-fewer-wins", "i-low", "ii-high", "ii-low", "iv"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("sorted %v, want %v", got, want)
+	}
+}
+
+func TestGetLadderAcrossDivisions(t *testing.T) {
+	// 30 players in Gold I and 40 in Gold II, on two pages each
+	division := func(rank string, players int) [][]map[string]any {
+		pages := make([][]map[string]any, 2)
+		for i := range players {
+			pages[i%2] = append(pages[i%2], map[string]any{
+				"puuid":        fmt.Sprintf("%s-%d", rank, i),
+				"queueType":    QueueRankedSolo,
+				"tier":         "GOLD",
+				"rank":         rank,
+				"leaguePoints": i,
+				"wins":         10,
+				"losses":       10,
+			})
+		}
+		return pages
+	}
+	divisions := map[string][][]map[string]any{"I": division("I", 30), "II": division("II", 40)}
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if puuid, ok := strings.CutPrefix(r.URL.Path, "/riot/account/v1/accounts/by-puuid/"); ok {
+			json.NewEncoder(w).Encode(map[string]string{"puuid": puuid, "gameName": "name-" + puuid, "tagLine": "EUW"})
+			return
+		}
+		parts := strings.Split(r.URL.Path, "/") // /lol/league/v4/entries/{queue}/{tier}/{division}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		entries := []map[string]any{}
+		if pages := divisions[parts[len(parts)-1]]; page >= 1 && page <= len(pages) {
+			entries = pages[page-1]
+		}
+		json.NewEncoder(w).Encode(entries)
+	})
+
+	ladder, err := client.GetLadder(QueueRankedSolo, "GOLD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ladder.Partial {
+		t.Fatal("ladder is partial, want every page read")
+	}
+	if len(ladder.Entries) != ladderSize {
+		t.Fatalf("got %d entries, want %d", len(ladder.Entries), ladderSize)
+	}
+
+	first, last := ladder.Entries[0], ladder.Entries[ladderSize-1]
+	if first.Position != 1 || first.PUUID != "I-29" || first.GameName != "name-I-29" {
+		t.Fatalf("first entry = %+v, want Gold I with the most LP at position 1", first)
+	}
+	if last.Position != ladderSize || last.PUUID != "II-20" {
+		t.Fatalf("last entry = %+v, want II-20 at position %d", last, ladderSize)
+	}
+}
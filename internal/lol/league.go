@@ -1,10 +1,14 @@
 package lol
 
 import (
-	"net/http"
-	"time"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/KnutZuidema/golio/riot/lol"
+
+	"lol-toolkit/internal/apierr"
 )
 
 // RankedInfo represents ranked league data for the frontend
@@ -26,32 +30,25 @@ type RankedInfo struct {
 
 // GetRankedStats fetches all ranked entries for a summoner
 func (c *Client) GetRankedStats(summonerID string) ([]*RankedInfo, error) {
-	start := time.Now()
-	entries, err := c.golio.Riot.LoL.League.ListBySummoner(summonerID)
-	duration := time.Since(start)
+	return c.rankedStats(summonerID, false)
+}
+
+// RefreshRankedStats fetches all ranked entries for a summoner, bypassing the response cache
+func (c *Client) RefreshRankedStats(summonerID string) ([]*RankedInfo, error) {
+	return c.rankedStats(summonerID, true)
+}
+
+func (c *Client) rankedStats(summonerID string, refresh bool) ([]*RankedInfo, error) {
+	entries, err := callWithCache(c, "league/by-summoner", []string{summonerID}, refresh, func() ([]*lol.LeagueItem, error) {
+		return c.golio.Riot.LoL.League.ListBySummoner(summonerID)
+	})
 	if err != nil {
-		LogError("GET", "league/by-summoner", duration, c.getHeaders(), err)
 		return nil, err
 	}
-	LogSuccess("GET", "league/by-summoner", http.StatusOK, duration, c.getHeaders(), c.marshalResponse(entries))
 
 	result := make([]*RankedInfo, len(entries))
 	for i, e := range entries {
-		result[i] = &RankedInfo{
-			QueueType:    e.QueueType,
-			Tier:         e.Tier,
-			Rank:         e.Rank,
-			LeaguePoints: e.LeaguePoints,
-			Wins:         e.Wins,
-			Losses:       e.Losses,
-			HotStreak:    e.HotStreak,
-			Veteran:      e.Veteran,
-			FreshBlood:   e.FreshBlood,
-			Inactive:     e.Inactive,
-			SummonerID:   e.SummonerID,
-			SummonerName: e.SummonerName,
-			PUUID:        e.PUUID,
-		}
+		result[i] = toRankedInfo(e)
 	}
 
 	return result, nil
@@ -59,53 +56,74 @@ func (c *Client) GetRankedStats(summonerID string) ([]*RankedInfo, error) {
 
 // GetChallengers fetches the challenger league for a queue
 func (c *Client) GetChallengers(queueType string) (*LeagueListInfo, error) {
-	start := time.Now()
-	league, err := c.golio.Riot.LoL.League.GetChallenger(lol.QueueRankedSolo)
-	if queueType == QueueRankedFlex {
-		league, err = c.golio.Riot.LoL.League.GetChallenger(lol.QueueRankedFlex)
-	}
-	duration := time.Since(start)
-	if err != nil {
-		LogError("GET", "league/challenger", duration, c.getHeaders(), err)
-		return nil, err
-	}
-	LogSuccess("GET", "league/challenger", http.StatusOK, duration, c.getHeaders(), c.marshalResponse(league))
-
-	return toLeagueListInfo(league), nil
+	return c.getApexLeague(queueType, TierChallenger)
 }
 
 // GetGrandmasters fetches the grandmaster league for a queue
 func (c *Client) GetGrandmasters(queueType string) (*LeagueListInfo, error) {
-	start := time.Now()
-	league, err := c.golio.Riot.LoL.League.GetGrandmaster(lol.QueueRankedSolo)
-	if queueType == QueueRankedFlex {
-		league, err = c.golio.Riot.LoL.League.GetGrandmaster(lol.QueueRankedFlex)
+	return c.getApexLeague(queueType, TierGrandmaster)
+}
+
+// GetMasters fetches the master league for a queue
+func (c *Client) GetMasters(queueType string) (*LeagueListInfo, error) {
+	return c.getApexLeague(queueType, TierMaster)
+}
+
+// getApexLeague fetches the whole league of an apex tier for a queue
+func (c *Client) getApexLeague(queueType, tier string) (*LeagueListInfo, error) {
+	if err := validateQueue(queueType); err != nil {
+		return nil, err
 	}
-	duration := time.Since(start)
+
+	endpoint := "league/" + strings.ToLower(tier)
+	league, err := cachedCall(c, endpoint, []string{queueType}, func() (*lol.LeagueList, error) {
+		var league lol.LeagueList
+		err := c.getPlatform(fmt.Sprintf("/lol/league/v4/%s/by-queue/%s", apexLeagues[tier], queueType), &league)
+		return &league, err
+	})
 	if err != nil {
-		LogError("GET", "league/grandmaster", duration, c.getHeaders(), err)
 		return nil, err
 	}
-	LogSuccess("GET", "league/grandmaster", http.StatusOK, duration, c.getHeaders(), c.marshalResponse(league))
 
 	return toLeagueListInfo(league), nil
 }
 
-// GetMasters fetches the master league for a queue
-func (c *Client) GetMasters(queueType string) (*LeagueListInfo, error) {
-	start := time.Now()
-	league, err := c.golio.Riot.LoL.League.GetMaster(lol.QueueRankedSolo)
-	if queueType == QueueRankedFlex {
-		league, err = c.golio.Riot.LoL.League.GetMaster(lol.QueueRankedFlex)
+// GetLeagueEntries fetches a page (starting at 1) of the players in a tier and division below Master.
+// Pages hold up to 205 entries in no particular order; an empty page means there are no more.
+func (c *Client) GetLeagueEntries(queueType, tier, division string, page int) ([]*RankedInfo, error) {
+	if err := validateQueue(queueType); err != nil {
+		return nil, err
 	}
-	duration := time.Since(start)
+	if !slices.Contains(Tiers, tier) || apexLeagues[tier] != "" {
+		return nil, apierr.New(apierr.CodeBadRequest, fmt.Sprintf("league entries are not available for tier %q", tier))
+	}
+	if !slices.Contains(Divisions, division) {
+		return nil, apierr.New(apierr.CodeBadRequest, fmt.Sprintf("unknown division %q", division))
+	}
+	page = max(page, 1)
+
+	entries, err := cachedCall(c, "league/entries", []string{queueType, tier, division, strconv.Itoa(page)}, func() ([]*lol.LeagueItem, error) {
+		var entries []*lol.LeagueItem
+		err := c.getPlatform(fmt.Sprintf("/lol/league/v4/entries/%s/%s/%s?page=%d", queueType, tier, division, page), &entries)
+		return entries, err
+	})
 	if err != nil {
-		LogError("GET", "league/master", duration, c.getHeaders(), err)
 		return nil, err
 	}
-	LogSuccess("GET", "league/master", http.StatusOK, duration, c.getHeaders(), c.marshalResponse(league))
 
-	return toLeagueListInfo(league), nil
+	result := make([]*RankedInfo, len(entries))
+	for i, e := range entries {
+		result[i] = toRankedInfo(e)
+	}
+	return result, nil
+}
+
+// validateQueue checks that queueType is a ranked queue with a ladder
+func validateQueue(queueType string) error {
+	if queueType != QueueRankedSolo && queueType != QueueRankedFlex {
+		return apierr.New(apierr.CodeBadRequest, fmt.Sprintf("unknown ranked queue %q", queueType))
+	}
+	return nil
 }
 
 // LeagueListInfo represents a league list for the frontend
@@ -120,21 +138,7 @@ type LeagueListInfo struct {
 func toLeagueListInfo(l *lol.LeagueList) *LeagueListInfo {
 	entries := make([]*RankedInfo, len(l.Entries))
 	for i, e := range l.Entries {
-		entries[i] = &RankedInfo{
-			QueueType:    e.QueueType,
-			Tier:         e.Tier,
-			Rank:         e.Rank,
-			LeaguePoints: e.LeaguePoints,
-			Wins:         e.Wins,
-			Losses:       e.Losses,
-			HotStreak:    e.HotStreak,
-			Veteran:      e.Veteran,
-			FreshBlood:   e.FreshBlood,
-			Inactive:     e.Inactive,
-			SummonerID:   e.SummonerID,
-			SummonerName: e.SummonerName,
-			PUUID:        e.PUUID,
-		}
+		entries[i] = toRankedInfo(e)
 	}
 
 	return &LeagueListInfo{
@@ -146,8 +150,57 @@ func toLeagueListInfo(l *lol.LeagueList) *LeagueListInfo {
 	}
 }
 
+// toRankedInfo converts a golio LeagueItem to our RankedInfo
+func toRankedInfo(e *lol.LeagueItem) *RankedInfo {
+	return &RankedInfo{
+		QueueType:    e.QueueType,
+		Tier:         e.Tier,
+		Rank:         e.Rank,
+		LeaguePoints: e.LeaguePoints,
+		Wins:         e.Wins,
+		Losses:       e.Losses,
+		HotStreak:    e.HotStreak,
+		Veteran:      e.Veteran,
+		FreshBlood:   e.FreshBlood,
+		Inactive:     e.Inactive,
+		SummonerID:   e.SummonerID,
+		SummonerName: e.SummonerName,
+		PUUID:        e.PUUID,
+	}
+}
+
 // Queue type constants
 const (
 	QueueRankedSolo = "RANKED_SOLO_5x5"
 	QueueRankedFlex = "RANKED_FLEX_SR"
 )
+
+// Tier constants
+const (
+	TierIron        = "IRON"
+	TierBronze      = "BRONZE"
+	TierSilver      = "SILVER"
+	TierGold        = "GOLD"
+	TierPlatinum    = "PLATINUM"
+	TierEmerald     = "EMERALD"
+	TierDiamond     = "DIAMOND"
+	TierMaster      = "MASTER"
+	TierGrandmaster = "GRANDMASTER"
+	TierChallenger  = "CHALLENGER"
+)
+
+// Tiers lists the ranked tiers from lowest to highest
+var Tiers = []string{
+	TierIron, TierBronze, TierSilver, TierGold, TierPlatinum,
+	TierEmerald, TierDiamond, TierMaster, TierGrandmaster, TierChallenger,
+}
+
+// Divisions lists the divisions of a tier from highest to lowest
+var Divisions = []string{"I", "II", "III", "IV"}
+
+// apexLeagues maps the apex tiers, which have a single league, to their league-v4 path
+var apexLeagues = map[string]string{
+	TierMaster:      "masterleagues",
+	TierGrandmaster: "grandmasterleagues",
+	TierChallenger:  "challengerleagues",
+}
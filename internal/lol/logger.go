@@ -1,13 +1,64 @@
 package lol
 
 import (
+	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"lol-toolkit/internal/logger"
+	"lol-toolkit/internal/retry"
 )
 
 const apiType = "riot"
 
+// defaultRetryClass is the endpoint class used when no policy is set for an endpoint's class.
+const defaultRetryClass = "default"
+
+// retryPolicies maps an endpoint class (the endpoint up to the first "/", e.g. "match")
+// to its retry policy. MaxAttempts is the number of requests sent: the rate limit transport
+// answers 503s itself, so golio does not retry them on top. 429s are not retried: they are
+// returned with the wait, and the rate limiter holds back later requests until it is over.
+var (
+	retryPolicies = map[string]retry.Policy{
+		defaultRetryClass: {
+			MaxAttempts:     3,
+			BaseDelay:       500 * time.Millisecond,
+			MaxDelay:        4 * time.Second,
+			RetryableStatus: []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+			RetryNetwork:    true,
+		},
+		// Match data is fetched in bulk by the history sync, so it is worth waiting longer
+		"match": {
+			MaxAttempts:     4,
+			BaseDelay:       time.Second,
+			MaxDelay:        8 * time.Second,
+			RetryableStatus: []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+			RetryNetwork:    true,
+		},
+	}
+	retryMutex sync.RWMutex
+)
+
+// SetRetryPolicy sets the retry policy for an endpoint class ("default" for all others).
+func SetRetryPolicy(class string, policy retry.Policy) {
+	retryMutex.Lock()
+	defer retryMutex.Unlock()
+	retryPolicies[class] = policy
+}
+
+// retryPolicy returns the retry policy for an endpoint.
+func retryPolicy(endpoint string) retry.Policy {
+	class, _, _ := strings.Cut(endpoint, "/")
+
+	retryMutex.RLock()
+	defer retryMutex.RUnlock()
+	if policy, ok := retryPolicies[class]; ok {
+		return policy
+	}
+	return retryPolicies[defaultRetryClass]
+}
+
 // APILogEntry is an alias for logger.APILogEntry for backward compatibility.
 type APILogEntry = logger.APILogEntry
 
@@ -16,11 +67,15 @@ func SetAPILogger(loggerFunc func(entry APILogEntry)) {
 	logger.SetAPILogger(loggerFunc)
 }
 
-// LoggedCall wraps an API call with automatic timing and logging.
-// It executes the provided function, measures duration, and logs the result.
+// LoggedCall wraps an API call with automatic timing, logging and retries.
+// It executes the provided function, measures duration, and logs each attempt.
+// Error responses are returned as *RiotError.
 // Uses generics to maintain type safety - no type assertions needed.
 func LoggedCall[T any](method, endpoint string, statusCode int, headers map[string]string, fn func() (T, error)) (T, error) {
-	return logger.LoggedCall(apiType, method, endpoint, statusCode, headers, fn)
+	return logger.LoggedRetryCall(apiType, retryPolicy(endpoint), method, endpoint, statusCode, headers, func() (T, error) {
+		result, err := fn()
+		return result, toRiotError(err)
+	})
 }
 
 // LogSuccess logs a successful API call.
@@ -29,7 +84,7 @@ func LogSuccess(method, endpoint string, statusCode int, duration time.Duration,
 }
 
 // LogError logs a failed API call.
-// If statusCode is 0, it will try to extract status code from the error, otherwise uses http.StatusInternalServerError as fallback.
+// If statusCode is not given, it is taken from the error.
 func LogError(method, endpoint string, duration time.Duration, headers map[string]string, err error, statusCode ...int) {
 	logger.LogError(apiType, method, endpoint, duration, headers, err, statusCode...)
 }
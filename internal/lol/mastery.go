@@ -1,8 +1,7 @@
 package lol
 
 import (
-	"net/http"
-	"time"
+	"github.com/KnutZuidema/golio/riot/lol"
 )
 
 // ChampionMasteryInfo represents champion mastery data for the frontend
@@ -20,14 +19,12 @@ type ChampionMasteryInfo struct {
 
 // GetChampionMastery fetches champion mastery for a summoner and champion
 func (c *Client) GetChampionMastery(summonerID string, championID string) (*ChampionMasteryInfo, error) {
-	start := time.Now()
-	mastery, err := c.golio.Riot.LoL.ChampionMastery.Get(summonerID, championID)
-	duration := time.Since(start)
+	mastery, err := cachedCall(c, "champion-mastery/get", []string{summonerID, championID}, func() (*lol.ChampionMastery, error) {
+		return c.golio.Riot.LoL.ChampionMastery.Get(summonerID, championID)
+	})
 	if err != nil {
-		LogError("GET", "champion-mastery/get", duration, c.getHeaders(), err)
 		return nil, err
 	}
-	LogSuccess("GET", "champion-mastery/get", http.StatusOK, duration, c.getHeaders(), c.marshalResponse(mastery))
 
 	return &ChampionMasteryInfo{
 		ChampionID:                   mastery.ChampionID,
@@ -44,14 +41,12 @@ func (c *Client) GetChampionMastery(summonerID string, championID string) (*Cham
 
 // GetAllChampionMasteries fetches all champion masteries for a summoner
 func (c *Client) GetAllChampionMasteries(summonerID string) ([]*ChampionMasteryInfo, error) {
-	start := time.Now()
-	masteries, err := c.golio.Riot.LoL.ChampionMastery.List(summonerID)
-	duration := time.Since(start)
+	masteries, err := cachedCall(c, "champion-mastery/list", []string{summonerID}, func() ([]*lol.ChampionMastery, error) {
+		return c.golio.Riot.LoL.ChampionMastery.List(summonerID)
+	})
 	if err != nil {
-		LogError("GET", "champion-mastery/list", duration, c.getHeaders(), err)
 		return nil, err
 	}
-	LogSuccess("GET", "champion-mastery/list", http.StatusOK, duration, c.getHeaders(), c.marshalResponse(masteries))
 
 	result := make([]*ChampionMasteryInfo, len(masteries))
 	for i, m := range masteries {
@@ -73,13 +68,7 @@ func (c *Client) GetAllChampionMasteries(summonerID string) ([]*ChampionMasteryI
 
 // GetTotalMasteryScore fetches the total mastery score for a summoner
 func (c *Client) GetTotalMasteryScore(summonerID string) (int, error) {
-	start := time.Now()
-	total, err := c.golio.Riot.LoL.ChampionMastery.GetTotal(summonerID)
-	duration := time.Since(start)
-	if err != nil {
-		LogError("GET", "champion-mastery/total", duration, c.getHeaders(), err)
-		return 0, err
-	}
-	LogSuccess("GET", "champion-mastery/total", http.StatusOK, duration, c.getHeaders(), c.marshalResponse(total))
-	return total, nil
+	return cachedCall(c, "champion-mastery/total", []string{summonerID}, func() (int, error) {
+		return c.golio.Riot.LoL.ChampionMastery.GetTotal(summonerID)
+	})
 }
@@ -0,0 +1,218 @@
+package lol
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+// Match list paging limits enforced by match-v5.
+const (
+	defaultMatchCount = 20
+	maxMatchCount     = 100
+)
+
+// MatchFilters narrows down a match ID list. Zero values mean "no filter".
+type MatchFilters struct {
+	Queue     int    `json:"queue"`     // queue ID, e.g. 420 for ranked solo
+	Type      string `json:"type"`      // "ranked", "normal", "tourney" or "tutorial"
+	Start     int    `json:"start"`     // index of the first match, for paging
+	Count     int    `json:"count"`     // number of matches, 1-100 (default 20)
+	StartTime int64  `json:"startTime"` // unix seconds
+	EndTime   int64  `json:"endTime"`   // unix seconds
+}
+
+// MatchInfo represents a match for the frontend
+type MatchInfo struct {
+	MatchID      string              `json:"matchId"`
+	GameCreation int64               `json:"gameCreation"`
+	GameDuration int                 `json:"gameDuration"` // seconds
+	GameMode     string              `json:"gameMode"`
+	GameVersion  string              `json:"gameVersion"`
+	QueueID      int                 `json:"queueId"`
+	MapID        int                 `json:"mapId"`
+	Teams        []*MatchTeam        `json:"teams"`
+	Participants []*MatchParticipant `json:"participants"`
+}
+
+// MatchTeam represents one team's result and bans
+type MatchTeam struct {
+	TeamID int   `json:"teamId"`
+	Win    bool  `json:"win"`
+	Bans   []int `json:"bans"`
+}
+
+// MatchParticipant represents a player's performance in a match
+type MatchParticipant struct {
+	PUUID          string      `json:"puuid"`
+	GameName       string      `json:"gameName"`
+	TagLine        string      `json:"tagLine"`
+	TeamID         int         `json:"teamId"`
+	Position       string      `json:"position"`
+	ChampionID     int         `json:"championId"`
+	ChampionName   string      `json:"championName"`
+	ChampLevel     int         `json:"champLevel"`
+	Kills          int         `json:"kills"`
+	Deaths         int         `json:"deaths"`
+	Assists        int         `json:"assists"`
+	KDA            float64     `json:"kda"`
+	CS             int         `json:"cs"`
+	GoldEarned     int         `json:"goldEarned"`
+	DamageDealt    int         `json:"damageDealt"` // to champions
+	DamageTaken    int         `json:"damageTaken"`
+	VisionScore    int         `json:"visionScore"`
+	Items          []int       `json:"items"` // slots 0-6, 6 is the trinket
+	Spell1ID       int         `json:"spell1Id"`
+	Spell2ID       int         `json:"spell2Id"`
+	Runes          *MatchRunes `json:"runes"`
+	Win            bool        `json:"win"`
+	EarlySurrender bool        `json:"earlySurrender"`
+}
+
+// MatchRunes represents a participant's rune selection
+type MatchRunes struct {
+	PrimaryStyleID int   `json:"primaryStyleId"`
+	SubStyleID     int   `json:"subStyleId"`
+	PerkIDs        []int `json:"perkIds"`   // primary then secondary runes
+	StatPerks      []int `json:"statPerks"` // offense, flex, defense
+}
+
+// GetMatchIDs fetches match IDs for a player, most recent first
+func (c *Client) GetMatchIDs(puuid string, filters MatchFilters) ([]string, error) {
+	count := filters.Count
+	if count <= 0 {
+		count = defaultMatchCount
+	}
+	if count > maxMatchCount {
+		return nil, fmt.Errorf("count must be at most %d", maxMatchCount)
+	}
+
+	options := &lol.MatchListOptions{Type: filters.Type}
+	if filters.Queue != 0 {
+		queue := filters.Queue
+		options.Queue = &queue
+	}
+	if filters.StartTime > 0 {
+		options.StartTime = time.Unix(filters.StartTime, 0)
+	}
+	if filters.EndTime > 0 {
+		options.EndTime = time.Unix(filters.EndTime, 0)
+	}
+
+	// Match-v5 is served by the regional route; golio switches the host from the platform
+	return riotCall(c, "match/by-puuid/ids", func() ([]string, error) {
+		return c.golio.Riot.LoL.Match.List(puuid, filters.Start, count, options)
+	})
+}
+
+// GetMatch fetches the full detail of a match
+func (c *Client) GetMatch(matchID string) (*MatchInfo, error) {
+	match, err := cachedCall(c, "match/by-id", []string{matchID}, func() (*lol.Match, error) {
+		return c.golio.Riot.LoL.Match.Get(matchID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if match.Info == nil {
+		return nil, fmt.Errorf("match %s has no info", matchID)
+	}
+
+	return toMatchInfo(match), nil
+}
+
+// toMatchInfo converts a golio Match to our MatchInfo
+func toMatchInfo(m *lol.Match) *MatchInfo {
+	info := &MatchInfo{
+		GameCreation: m.Info.GameCreation,
+		GameDuration: m.Info.GameDuration,
+		GameMode:     m.Info.GameMode,
+		GameVersion:  m.Info.GameVersion,
+		QueueID:      m.Info.QueueID,
+		MapID:        m.Info.MapID,
+		Teams:        make([]*MatchTeam, 0, len(m.Info.Teams)),
+		Participants: make([]*MatchParticipant, 0, len(m.Info.Participants)),
+	}
+	if m.Metadata != nil {
+		info.MatchID = m.Metadata.MatchID
+	}
+
+	for _, t := range m.Info.Teams {
+		team := &MatchTeam{TeamID: t.TeamID, Win: t.Win, Bans: make([]int, 0, len(t.Bans))}
+		for _, ban := range t.Bans {
+			team.Bans = append(team.Bans, ban.ChampionID)
+		}
+		info.Teams = append(info.Teams, team)
+	}
+
+	for _, p := range m.Info.Participants {
+		info.Participants = append(info.Participants, toMatchParticipant(p))
+	}
+
+	return info
+}
+
+// toMatchParticipant converts a golio Participant to our MatchParticipant
+func toMatchParticipant(p *lol.Participant) *MatchParticipant {
+	gameName := p.RiotIDGameName
+	if gameName == "" {
+		gameName = p.SummonerName
+	}
+
+	return &MatchParticipant{
+		PUUID:          p.PUUID,
+		GameName:       gameName,
+		TagLine:        p.RiotIDTagline,
+		TeamID:         p.TeamID,
+		Position:       p.TeamPosition,
+		ChampionID:     p.ChampionID,
+		ChampionName:   p.ChampionName,
+		ChampLevel:     p.ChampLevel,
+		Kills:          p.Kills,
+		Deaths:         p.Deaths,
+		Assists:        p.Assists,
+		KDA:            kda(p.Kills, p.Deaths, p.Assists),
+		CS:             p.TotalMinionsKilled + p.NeutralMinionsKilled,
+		GoldEarned:     p.GoldEarned,
+		DamageDealt:    p.TotalDamageDealtToChampions,
+		DamageTaken:    p.TotalDamageTaken,
+		VisionScore:    p.VisionScore,
+		Items:          []int{p.Item0, p.Item1, p.Item2, p.Item3, p.Item4, p.Item5, p.Item6},
+		Spell1ID:       p.Summoner1ID,
+		Spell2ID:       p.Summoner2ID,
+		Runes:          toMatchRunes(p.Perks),
+		Win:            p.Win,
+		EarlySurrender: p.GameEndedInEarlySurrender,
+	}
+}
+
+// toMatchRunes flattens a participant's rune styles
+func toMatchRunes(perks *lol.ParticipantPerks) *MatchRunes {
+	runes := &MatchRunes{PerkIDs: []int{}, StatPerks: []int{}}
+	if perks == nil {
+		return runes
+	}
+
+	for i, style := range perks.Styles {
+		switch i {
+		case 0:
+			runes.PrimaryStyleID = style.Style
+		case 1:
+			runes.SubStyleID = style.Style
+		}
+		for _, selection := range style.Selections {
+			runes.PerkIDs = append(runes.PerkIDs, selection.Perk)
+		}
+	}
+
+	if perks.StatPerks != nil {
+		runes.StatPerks = []int{perks.StatPerks.Offense, perks.StatPerks.Flex, perks.StatPerks.Defense}
+	}
+
+	return runes
+}
+
+// kda returns (kills + assists) / deaths, treating zero deaths as one
+func kda(kills, deaths, assists int) float64 {
+	return float64(kills+assists) / float64(max(deaths, 1))
+}
@@ -0,0 +1,347 @@
+package lol
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultAppRateLimit is the development key limit, used until the first response reports the real one.
+const defaultAppRateLimit = "20:1,100:120"
+
+// defaultRetryAfter is used when a 429 response has no Retry-After header.
+const defaultRetryAfter = time.Second
+
+// RateLimitState describes one rate limit scope for the frontend
+type RateLimitState struct {
+	Host         string            `json:"host"`
+	Method       string            `json:"method,omitempty"` // empty for the application limit
+	Windows      []RateLimitWindow `json:"windows"`
+	BlockedForMs int64             `json:"blockedForMs"` // remaining Retry-After wait
+}
+
+// RateLimitWindow is a single "limit per window" bucket
+type RateLimitWindow struct {
+	Limit         int   `json:"limit"`
+	Used          int   `json:"used"`
+	WindowSeconds int   `json:"windowSeconds"`
+	ResetsInMs    int64 `json:"resetsInMs"`
+}
+
+// RateLimiter throttles Riot API requests using the application and method limits
+// reported in response headers. Each host (platform or regional route) has its own buckets.
+type RateLimiter struct {
+	mu     sync.Mutex
+	scopes map[scopeKey]*rateScope
+}
+
+// scopeKey identifies a rate limit scope; method is empty for the application limit.
+type scopeKey struct {
+	host   string
+	method string
+}
+
+// rateScope is the set of windows that apply to one scope.
+type rateScope struct {
+	windows      []*rateWindow
+	blockedUntil time.Time
+}
+
+// rateWindow counts requests in a fixed window that starts with the first request.
+type rateWindow struct {
+	limit  int
+	window time.Duration
+	used   int
+	start  time.Time // zero until the first request of the window
+}
+
+// NewRateLimiter creates a rate limiter with no known limits.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		scopes: make(map[scopeKey]*rateScope),
+	}
+}
+
+// Transport wraps next so every request waits for the limiter and reports its headers back.
+func (l *RateLimiter) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &rateLimitTransport{next: next, limiter: l}
+}
+
+// Wait blocks until a request to host and method may be sent, then reserves it.
+func (l *RateLimiter) Wait(ctx context.Context, host, method string) error {
+	for {
+		l.mu.Lock()
+		delay := l.reserve(host, method, time.Now())
+		l.mu.Unlock()
+
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Update records the limits, counts and Retry-After of a response.
+func (l *RateLimiter) Update(host, method string, statusCode int, header http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	app := l.scope(scopeKey{host: host})
+	if limits := header.Get("X-App-Rate-Limit"); limits != "" {
+		app.setLimits(limits, header.Get("X-App-Rate-Limit-Count"), now)
+	}
+
+	methodScope := l.scope(scopeKey{host: host, method: method})
+	if limits := header.Get("X-Method-Rate-Limit"); limits != "" {
+		methodScope.setLimits(limits, header.Get("X-Method-Rate-Limit-Count"), now)
+	}
+
+	if statusCode != http.StatusTooManyRequests {
+		return
+	}
+
+	retryAfter := defaultRetryAfter
+	if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil {
+		retryAfter = time.Duration(seconds) * time.Second
+	}
+
+	// "application" blocks every method on the host; "method" and "service" only this method
+	blocked := methodScope
+	if header.Get("X-Rate-Limit-Type") == "application" {
+		blocked = app
+	}
+	blocked.blockedUntil = now.Add(retryAfter)
+}
+
+// longestBlock returns the longest remaining Retry-After wait of any scope, 0 if none is blocked.
+func (l *RateLimiter) longestBlock() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	var longest time.Duration
+	for _, scope := range l.scopes {
+		longest = max(longest, scope.blockedUntil.Sub(now))
+	}
+	return longest
+}
+
+// State returns a snapshot of every known scope, sorted by host and method.
+func (l *RateLimiter) State() []RateLimitState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	states := make([]RateLimitState, 0, len(l.scopes))
+	for key, scope := range l.scopes {
+		state := RateLimitState{
+			Host:         key.host,
+			Method:       key.method,
+			Windows:      make([]RateLimitWindow, 0, len(scope.windows)),
+			BlockedForMs: max(scope.blockedUntil.Sub(now).Milliseconds(), 0),
+		}
+		for _, w := range scope.windows {
+			w.expire(now)
+			window := RateLimitWindow{
+				Limit:         w.limit,
+				Used:          w.used,
+				WindowSeconds: int(w.window / time.Second),
+			}
+			if !w.start.IsZero() {
+				window.ResetsInMs = w.start.Add(w.window).Sub(now).Milliseconds()
+			}
+			state.Windows = append(state.Windows, window)
+		}
+		states = append(states, state)
+	}
+
+	sort.Slice(states, func(i, j int) bool {
+		if states[i].Host != states[j].Host {
+			return states[i].Host < states[j].Host
+		}
+		return states[i].Method < states[j].Method
+	})
+	return states
+}
+
+// reserve returns how long to wait, or takes a slot in every applicable window and returns 0.
+// The caller must hold l.mu.
+func (l *RateLimiter) reserve(host, method string, now time.Time) time.Duration {
+	app := l.scope(scopeKey{host: host})
+	if len(app.windows) == 0 {
+		app.setLimits(defaultAppRateLimit, "", now)
+	}
+	methodScope := l.scope(scopeKey{host: host, method: method})
+
+	if delay := max(app.delay(now), methodScope.delay(now)); delay > 0 {
+		return delay
+	}
+
+	app.take(now)
+	methodScope.take(now)
+	return 0
+}
+
+// scope returns the scope for key, creating it if needed. The caller must hold l.mu.
+func (l *RateLimiter) scope(key scopeKey) *rateScope {
+	scope, ok := l.scopes[key]
+	if !ok {
+		scope = &rateScope{}
+		l.scopes[key] = scope
+	}
+	return scope
+}
+
+// delay returns how long until the scope allows another request.
+func (s *rateScope) delay(now time.Time) time.Duration {
+	delay := s.blockedUntil.Sub(now)
+	for _, w := range s.windows {
+		w.expire(now)
+		if w.used >= w.limit {
+			delay = max(delay, w.start.Add(w.window).Sub(now))
+		}
+	}
+	return delay
+}
+
+// take counts a request in every window.
+func (s *rateScope) take(now time.Time) {
+	for _, w := range s.windows {
+		if w.start.IsZero() {
+			w.start = now
+		}
+		w.used++
+	}
+}
+
+// setLimits replaces the windows with the "limit:seconds,..." spec, syncing counts
+// from the matching "count:seconds,..." header. Existing windows keep their progress.
+func (s *rateScope) setLimits(limits, counts string, now time.Time) {
+	reported := parseRateLimitHeader(counts)
+
+	existing := make(map[time.Duration]*rateWindow, len(s.windows))
+	for _, w := range s.windows {
+		existing[w.window] = w
+	}
+
+	s.windows = s.windows[:0]
+	for window, limit := range parseRateLimitHeader(limits) {
+		w, ok := existing[window]
+		if !ok {
+			w = &rateWindow{window: window}
+		}
+		w.limit = limit
+		w.expire(now)
+		if count := reported[window]; count > w.used {
+			w.used = count
+			if w.start.IsZero() {
+				w.start = now
+			}
+		}
+		s.windows = append(s.windows, w)
+	}
+	sort.Slice(s.windows, func(i, j int) bool { return s.windows[i].window < s.windows[j].window })
+}
+
+// expire resets the window once it has elapsed.
+func (w *rateWindow) expire(now time.Time) {
+	if !w.start.IsZero() && !now.Before(w.start.Add(w.window)) {
+		w.start = time.Time{}
+		w.used = 0
+	}
+}
+
+// parseRateLimitHeader parses "20:1,100:120" into a map of window to value.
+func parseRateLimitHeader(header string) map[time.Duration]int {
+	values := make(map[time.Duration]int)
+	for _, part := range strings.Split(header, ",") {
+		value, seconds, ok := strings.Cut(strings.TrimSpace(part), ":")
+		if !ok {
+			continue
+		}
+		v, err1 := strconv.Atoi(value)
+		s, err2 := strconv.Atoi(seconds)
+		if err1 != nil || err2 != nil || s <= 0 {
+			continue
+		}
+		values[time.Duration(s)*time.Second] = v
+	}
+	return values
+}
+
+// staticSegment matches path segments that are part of an endpoint rather than a parameter.
+var staticSegment = regexp.MustCompile(`^([a-z][a-z-]*|v\d+)$`)
+
+// methodKey reduces a request path to its endpoint ("method" in Riot terms) by replacing
+// parameters with "{}", e.g. /lol/match/v5/matches/{}/timeline.
+func methodKey(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	afterRiotID := false
+	for i, segment := range segments {
+		if afterRiotID || (i > 0 && strings.HasPrefix(segments[i-1], "by-")) || !staticSegment.MatchString(segment) {
+			segments[i] = "{}"
+		}
+		// game name and tag line are both parameters
+		if segment == "by-riot-id" {
+			afterRiotID = true
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// rateLimitTransport applies a RateLimiter to an http.RoundTripper.
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *RateLimiter
+}
+
+// RoundTrip waits for the limiter, sends the request and records the response headers.
+// A 429 is returned as a *RiotError carrying the wait: golio would otherwise sleep and
+// resend it, or fail with a parse error when the response has no Retry-After header.
+// A 503 is returned as a *RiotError too, so only the retry policy of the call retries it,
+// not golio as well.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Host
+	method := methodKey(req.URL.Path)
+
+	if err := t.limiter.Wait(req.Context(), host, method); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	t.limiter.Update(host, method, resp.StatusCode, resp.Header)
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		resp.Body.Close()
+		riotErr := newRiotError(resp)
+		if riotErr.RetryAfter == 0 {
+			riotErr.RetryAfter = t.limiter.longestBlock()
+		}
+		return nil, riotErr
+	case http.StatusServiceUnavailable:
+		resp.Body.Close()
+		return nil, newRiotError(resp)
+	}
+	return resp, nil
+}
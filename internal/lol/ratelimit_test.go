@@ -0,0 +1,93 @@
+package lol
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"lol-toolkit/internal/apierr"
+	"lol-toolkit/internal/retry"
+)
+
+// redirectTransport sends every request to a test server, keeping the path.
+type redirectTransport struct {
+	target *url.URL
+}
+
+func (t redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newTestClient returns a client whose requests reach handler through the rate limiter.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	target, _ := url.Parse(server.URL)
+	client, err := NewClient("RGAPI-00000000-0000-0000-0000-000000000000", "euw1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.http.Transport = client.limiter.Transport(redirectTransport{target: target})
+	return client
+}
+
+func TestRateLimitedWithoutRetryAfter(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Rate-Limit-Type", "service")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	calls := map[string]func() error{
+		"golio": func() error {
+			_, err := client.GetSummonerByPUUID("puuid")
+			return err
+		},
+		"direct": func() error {
+			_, err := client.getAccountByPUUID("puuid")
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			err := call()
+			if code := apierr.CodeOf(err); code != apierr.CodeRateLimited {
+				t.Fatalf("CodeOf(%v) = %q, want %q", err, code, apierr.CodeRateLimited)
+			}
+			var riotErr *RiotError
+			if !errors.As(err, &riotErr) || riotErr.RetryAfter <= 0 {
+				t.Fatalf("err = %#v, want a *RiotError with RetryAfter set", err)
+			}
+		})
+	}
+}
+
+func TestServiceUnavailableRetriedByPolicyOnly(t *testing.T) {
+	previous := retryPolicy("summoner")
+	SetRetryPolicy("summoner", retry.Policy{
+		MaxAttempts:     3,
+		RetryableStatus: []int{http.StatusServiceUnavailable},
+	})
+	defer SetRetryPolicy("summoner", previous)
+
+	var requests atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := client.GetSummonerByPUUID("puuid")
+	if code := apierr.CodeOf(err); code != apierr.CodeUnavailable {
+		t.Fatalf("CodeOf(%v) = %q, want %q", err, code, apierr.CodeUnavailable)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Fatalf("sent %d requests, want 3", got)
+	}
+}
@@ -0,0 +1,94 @@
+package lol
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KnutZuidema/golio/api"
+
+	"lol-toolkit/internal/apierr"
+)
+
+// ErrUnknownRegion is returned for a region that is not a known platform.
+var ErrUnknownRegion = apierr.New(apierr.CodeBadRequest, "unknown region")
+
+// Regional clusters serving the endpoints that are not per platform
+const (
+	ClusterAmericas = "americas"
+	ClusterAsia     = "asia"
+	ClusterEurope   = "europe"
+	ClusterSEA      = "sea"
+)
+
+// Platform is a League server and the clusters its regional endpoints are routed to
+type Platform struct {
+	ID             string `json:"id"` // platform routing value, e.g. "euw1"
+	Name           string `json:"name"`
+	Cluster        string `json:"cluster"`        // match-v5 and other regional endpoints
+	AccountCluster string `json:"accountCluster"` // account-v1, which has no SEA cluster
+}
+
+// platforms lists the supported platforms in display order.
+// account-v1 serves every account from any cluster, so the SEA platforms use the nearest one.
+var platforms = []Platform{
+	{ID: "na1", Name: "North America", Cluster: ClusterAmericas, AccountCluster: ClusterAmericas},
+	{ID: "br1", Name: "Brazil", Cluster: ClusterAmericas, AccountCluster: ClusterAmericas},
+	{ID: "la1", Name: "Latin America North", Cluster: ClusterAmericas, AccountCluster: ClusterAmericas},
+	{ID: "la2", Name: "Latin America South", Cluster: ClusterAmericas, AccountCluster: ClusterAmericas},
+	{ID: "euw1", Name: "Europe West", Cluster: ClusterEurope, AccountCluster: ClusterEurope},
+	{ID: "eun1", Name: "Europe Nordic & East", Cluster: ClusterEurope, AccountCluster: ClusterEurope},
+	{ID: "tr1", Name: "Turkey", Cluster: ClusterEurope, AccountCluster: ClusterEurope},
+	{ID: "ru", Name: "Russia", Cluster: ClusterEurope, AccountCluster: ClusterEurope},
+	{ID: "me1", Name: "Middle East", Cluster: ClusterEurope, AccountCluster: ClusterEurope},
+	{ID: "kr", Name: "Korea", Cluster: ClusterAsia, AccountCluster: ClusterAsia},
+	{ID: "jp1", Name: "Japan", Cluster: ClusterAsia, AccountCluster: ClusterAsia},
+	{ID: "oc1", Name: "Oceania", Cluster: ClusterSEA, AccountCluster: ClusterAmericas},
+	{ID: "sg2", Name: "Southeast Asia", Cluster: ClusterSEA, AccountCluster: ClusterAsia},
+	{ID: "tw2", Name: "Taiwan", Cluster: ClusterSEA, AccountCluster: ClusterAsia},
+	{ID: "vn2", Name: "Vietnam", Cluster: ClusterSEA, AccountCluster: ClusterAsia},
+}
+
+// platformAliases maps older or informal region names to platform IDs.
+var platformAliases = map[string]string{
+	"sea": "sg2",
+	"ph2": "sg2",
+	"th2": "sg2",
+}
+
+// Platforms returns every supported platform.
+func Platforms() []Platform {
+	result := make([]Platform, len(platforms))
+	copy(result, platforms)
+	return result
+}
+
+// LookupPlatform finds the platform for a region code, ignoring case. An empty region is DefaultRegion.
+func LookupPlatform(region string) (Platform, error) {
+	id := strings.ToLower(strings.TrimSpace(region))
+	if id == "" {
+		id = DefaultRegion
+	}
+	if alias, ok := platformAliases[id]; ok {
+		id = alias
+	}
+
+	for _, p := range platforms {
+		if p.ID == id {
+			return p, nil
+		}
+	}
+	return Platform{}, fmt.Errorf("%w %q", ErrUnknownRegion, region)
+}
+
+// clusterFor returns the cluster that serves a regional endpoint path.
+func (p Platform) clusterFor(endpoint string) string {
+	if strings.HasPrefix(endpoint, "/riot/account/") {
+		return p.AccountCluster
+	}
+	return p.Cluster
+}
+
+// golioRegion returns the golio region for the platform.
+func (p Platform) golioRegion() api.Region {
+	return api.Region(p.ID)
+}
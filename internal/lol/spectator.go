@@ -0,0 +1,118 @@
+package lol
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+// Spectator team IDs
+const (
+	TeamBlue = 100
+	TeamRed  = 200
+)
+
+// ActiveGame represents a game in progress for the frontend
+type ActiveGame struct {
+	GameID         int           `json:"gameId"`
+	GameMode       string        `json:"gameMode"`
+	GameType       string        `json:"gameType"`
+	QueueID        int           `json:"queueId"`
+	MapID          int           `json:"mapId"`
+	GameStartTime  int64         `json:"gameStartTime"`  // unix milliseconds, 0 while loading
+	ElapsedSeconds int           `json:"elapsedSeconds"` // at the time of the request
+	Teams          []*ActiveTeam `json:"teams"`          // blue side first
+}
+
+// ActiveTeam is one side of a game in progress
+type ActiveTeam struct {
+	TeamID       int                  `json:"teamId"`
+	Participants []*ActiveParticipant `json:"participants"`
+	Bans         []int                `json:"bans"` // champion IDs in pick turn order, -1 for no ban
+}
+
+// ActiveParticipant is a player in a game in progress.
+// Name and rank are not part of the spectator data and are filled in by the caller.
+type ActiveParticipant struct {
+	PUUID         string        `json:"puuid"`
+	SummonerID    string        `json:"summonerId"`
+	GameName      string        `json:"gameName"`
+	TagLine       string        `json:"tagLine"`
+	ChampionID    int           `json:"championId"`
+	Spell1ID      int           `json:"spell1Id"`
+	Spell2ID      int           `json:"spell2Id"`
+	ProfileIconID int           `json:"profileIconId"`
+	Bot           bool          `json:"bot"`
+	Ranked        []*RankedInfo `json:"ranked"`
+}
+
+// GetActiveGame fetches the game a player is currently in, or nil if they are not in one
+func (c *Client) GetActiveGame(puuid string) (*ActiveGame, error) {
+	game, err := riotCall(c, "spectator/active-game", func() (*lol.GameInfo, error) {
+		return c.golio.Riot.LoL.Spectator.GetCurrent(puuid)
+	})
+	if errors.Is(err, api.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toActiveGame(game, time.Now()), nil
+}
+
+func toActiveGame(g *lol.GameInfo, now time.Time) *ActiveGame {
+	game := &ActiveGame{
+		GameID:         g.GameID,
+		GameMode:       g.GameMode,
+		GameType:       g.GameType,
+		QueueID:        g.GameQueueConfigID,
+		MapID:          g.MapID,
+		GameStartTime:  int64(g.GameStartTime),
+		ElapsedSeconds: g.GameLength,
+	}
+	if game.GameStartTime > 0 {
+		game.ElapsedSeconds = max(int(now.Sub(time.UnixMilli(game.GameStartTime)).Seconds()), 0)
+	}
+
+	teams := make(map[int]*ActiveTeam)
+	team := func(id int) *ActiveTeam {
+		t, ok := teams[id]
+		if !ok {
+			t = &ActiveTeam{TeamID: id, Participants: []*ActiveParticipant{}, Bans: []int{}}
+			teams[id] = t
+			game.Teams = append(game.Teams, t)
+		}
+		return t
+	}
+	// Both sides are listed even if one has no participants, e.g. in practice tool
+	team(TeamBlue)
+	team(TeamRed)
+
+	for _, p := range g.Participants {
+		t := team(p.TeamID)
+		t.Participants = append(t.Participants, &ActiveParticipant{
+			PUUID:         p.PUUID,
+			SummonerID:    p.SummonerID,
+			ChampionID:    p.ChampionID,
+			Spell1ID:      p.Spell1ID,
+			Spell2ID:      p.Spell2ID,
+			ProfileIconID: p.ProfileIconID,
+			Bot:           p.Bot,
+			Ranked:        []*RankedInfo{},
+		})
+	}
+
+	bans := slices.Clone(g.BannedChampions)
+	slices.SortFunc(bans, func(a, b *lol.BannedChampion) int { return a.PickTurn - b.PickTurn })
+	for _, ban := range bans {
+		t := team(ban.TeamID)
+		t.Bans = append(t.Bans, ban.ChampionID)
+	}
+
+	slices.SortFunc(game.Teams, func(a, b *ActiveTeam) int { return a.TeamID - b.TeamID })
+	return game
+}
@@ -2,9 +2,8 @@ package lol
 
 import (
 	"fmt"
-	"net/http"
+	"net/url"
 	"strings"
-	"time"
 
 	"github.com/KnutZuidema/golio/riot/account"
 	"github.com/KnutZuidema/golio/riot/lol"
@@ -37,16 +36,18 @@ func (c *Client) SearchByRiotID(riotID string) (*SummonerInfo, error) {
 		return nil, fmt.Errorf("both game name and tag line are required")
 	}
 
-	// Get account by Riot ID - type-safe with generics
-	account, err := LoggedCall("GET", "account/by-riot-id", http.StatusOK, c.getHeaders(), func() (*account.Account, error) {
-		return c.golio.Riot.Account.GetByRiotID(gameName, tagLine)
+	// Get account by Riot ID - account-v1 is routed to the platform's account cluster
+	account, err := cachedCall(c, "account/by-riot-id", []string{strings.ToLower(gameName), strings.ToLower(tagLine)}, func() (*account.Account, error) {
+		var result account.Account
+		err := c.getRegional(fmt.Sprintf("/riot/account/v1/accounts/by-riot-id/%s/%s", url.PathEscape(gameName), url.PathEscape(tagLine)), &result)
+		return &result, err
 	})
 	if err != nil {
 		return nil, fmt.Errorf("account not found: %w", err)
 	}
 
 	// Get summoner by PUUID - type-safe with generics
-	summoner, err := LoggedCall("GET", "summoner/by-puuid", http.StatusOK, c.getHeaders(), func() (*lol.Summoner, error) {
+	summoner, err := cachedCall(c, "summoner/by-puuid", []string{account.Puuid}, func() (*lol.Summoner, error) {
 		return c.golio.Riot.LoL.Summoner.GetByPUUID(account.Puuid)
 	})
 	if err != nil {
@@ -67,53 +68,50 @@ func (c *Client) SearchByRiotID(riotID string) (*SummonerInfo, error) {
 
 // GetSummonerByPUUID fetches summoner info by PUUID
 func (c *Client) GetSummonerByPUUID(puuid string) (*SummonerInfo, error) {
-	start := time.Now()
-	summoner, err := c.golio.Riot.LoL.Summoner.GetByPUUID(puuid)
-	duration := time.Since(start)
+	summoner, err := cachedCall(c, "summoner/by-puuid", []string{puuid}, func() (*lol.Summoner, error) {
+		return c.golio.Riot.LoL.Summoner.GetByPUUID(puuid)
+	})
 	if err != nil {
-		LogError("GET", "summoner/by-puuid", duration, c.getHeaders(), err)
 		return nil, err
 	}
-	LogSuccess("GET", "summoner/by-puuid", http.StatusOK, duration, c.getHeaders(), c.marshalResponse(summoner))
 
 	// Also get account info for GameName and TagLine
-	start = time.Now()
-	account, err := c.golio.Riot.Account.GetByPUUID(puuid)
-	duration = time.Since(start)
+	account, err := c.getAccountByPUUID(puuid)
 	if err != nil {
-		LogError("GET", "account/by-puuid", duration, c.getHeaders(), err)
 		// Return summoner info without GameName/TagLine if account lookup fails
 		return toSummonerInfo(summoner, "", ""), nil
 	}
-	LogSuccess("GET", "account/by-puuid", http.StatusOK, duration, c.getHeaders(), c.marshalResponse(account))
 
 	return toSummonerInfo(summoner, account.GameName, account.TagLine), nil
 }
 
 // GetSummonerByID fetches summoner info by summoner ID
 func (c *Client) GetSummonerByID(summonerID string) (*SummonerInfo, error) {
-	start := time.Now()
-	summoner, err := c.golio.Riot.LoL.Summoner.GetByID(summonerID)
-	duration := time.Since(start)
+	summoner, err := cachedCall(c, "summoner/by-id", []string{summonerID}, func() (*lol.Summoner, error) {
+		return c.golio.Riot.LoL.Summoner.GetByID(summonerID)
+	})
 	if err != nil {
-		LogError("GET", "summoner/by-id", duration, c.getHeaders(), err)
 		return nil, err
 	}
-	LogSuccess("GET", "summoner/by-id", http.StatusOK, duration, c.getHeaders(), c.marshalResponse(summoner))
 
 	// Also get account info for GameName and TagLine
-	start = time.Now()
-	account, err := c.golio.Riot.Account.GetByPUUID(summoner.PUUID)
-	duration = time.Since(start)
+	account, err := c.getAccountByPUUID(summoner.PUUID)
 	if err != nil {
-		LogError("GET", "account/by-puuid", duration, c.getHeaders(), err)
 		return toSummonerInfo(summoner, "", ""), nil
 	}
-	LogSuccess("GET", "account/by-puuid", http.StatusOK, duration, c.getHeaders(), c.marshalResponse(account))
 
 	return toSummonerInfo(summoner, account.GameName, account.TagLine), nil
 }
 
+// getAccountByPUUID fetches the Riot account (game name and tag line) for a PUUID
+func (c *Client) getAccountByPUUID(puuid string) (*account.Account, error) {
+	return cachedCall(c, "account/by-puuid", []string{puuid}, func() (*account.Account, error) {
+		var result account.Account
+		err := c.getRegional("/riot/account/v1/accounts/by-puuid/"+puuid, &result)
+		return &result, err
+	})
+}
+
 // toSummonerInfo converts golio Summoner to our SummonerInfo
 func toSummonerInfo(s *lol.Summoner, gameName, tagLine string) *SummonerInfo {
 	return &SummonerInfo{
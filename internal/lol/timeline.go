@@ -0,0 +1,98 @@
+package lol
+
+import (
+	"fmt"
+)
+
+// Timeline event types used by the analysis
+const (
+	EventChampionKill     = "CHAMPION_KILL"
+	EventBuildingKill     = "BUILDING_KILL"
+	EventEliteMonsterKill = "ELITE_MONSTER_KILL"
+
+	buildingTower = "TOWER_BUILDING"
+)
+
+// MatchTimeline represents a match-v5 timeline for the frontend
+type MatchTimeline struct {
+	MatchID       string                 `json:"matchId"`
+	FrameInterval int                    `json:"frameInterval"` // milliseconds
+	Participants  []*TimelineParticipant `json:"participants"`
+	Frames        []*TimelineFrame       `json:"frames"`
+}
+
+// TimelineParticipant maps a timeline participant ID to a player
+type TimelineParticipant struct {
+	ParticipantID int    `json:"participantId"`
+	PUUID         string `json:"puuid"`
+}
+
+// TimelineFrame is a snapshot of every participant plus the events since the previous frame
+type TimelineFrame struct {
+	Timestamp         int                          `json:"timestamp"` // milliseconds
+	ParticipantFrames map[string]*ParticipantFrame `json:"participantFrames"`
+	Events            []*TimelineEvent             `json:"events"`
+}
+
+// ParticipantFrame is a participant's state at a frame
+type ParticipantFrame struct {
+	ParticipantID       int `json:"participantId"`
+	Level               int `json:"level"`
+	XP                  int `json:"xp"`
+	CurrentGold         int `json:"currentGold"`
+	TotalGold           int `json:"totalGold"`
+	MinionsKilled       int `json:"minionsKilled"`
+	JungleMinionsKilled int `json:"jungleMinionsKilled"`
+}
+
+// TimelineEvent is a single timeline event (kill, objective, item, ...)
+type TimelineEvent struct {
+	Type                    string `json:"type"`
+	Timestamp               int    `json:"timestamp"` // milliseconds
+	ParticipantID           int    `json:"participantId,omitempty"`
+	KillerID                int    `json:"killerId,omitempty"`
+	VictimID                int    `json:"victimId,omitempty"`
+	AssistingParticipantIDs []int  `json:"assistingParticipantIds,omitempty"`
+	TeamID                  int    `json:"teamId,omitempty"`
+	KillerTeamID            int    `json:"killerTeamId,omitempty"`
+	MonsterType             string `json:"monsterType,omitempty"`
+	MonsterSubType          string `json:"monsterSubType,omitempty"`
+	BuildingType            string `json:"buildingType,omitempty"`
+	TowerType               string `json:"towerType,omitempty"`
+	LaneType                string `json:"laneType,omitempty"`
+}
+
+// timelineResponse is the raw match-v5 timeline response.
+type timelineResponse struct {
+	Metadata struct {
+		MatchID string `json:"matchId"`
+	} `json:"metadata"`
+	Info struct {
+		FrameInterval int                    `json:"frameInterval"`
+		Frames        []*TimelineFrame       `json:"frames"`
+		Participants  []*TimelineParticipant `json:"participants"`
+	} `json:"info"`
+}
+
+// GetMatchTimeline fetches the minute-by-minute timeline of a match.
+// Timelines are not available for every match (e.g. very old or custom games).
+func (c *Client) GetMatchTimeline(matchID string) (*MatchTimeline, error) {
+	// golio fetches the v5 timeline from the platform host and decodes it as v4, so it is requested directly
+	response, err := cachedCall(c, "match/timeline", []string{matchID}, func() (*timelineResponse, error) {
+		var response timelineResponse
+		if err := c.getRegional(fmt.Sprintf("/lol/match/v5/matches/%s/timeline", matchID), &response); err != nil {
+			return nil, err
+		}
+		return &response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MatchTimeline{
+		MatchID:       response.Metadata.MatchID,
+		FrameInterval: response.Info.FrameInterval,
+		Participants:  response.Info.Participants,
+		Frames:        response.Info.Frames,
+	}, nil
+}
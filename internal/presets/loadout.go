@@ -0,0 +1,153 @@
+package presets
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// Preset files in the config directory.
+const (
+	spellsFile   = "spells.json"
+	itemSetsFile = "itemsets.json"
+)
+
+// SpellPreset is a saved summoner spell pair for a champion, role and queue.
+// An empty Position or zero QueueID matches any role or queue.
+type SpellPreset struct {
+	ChampionID int    `json:"championId"`
+	Position   string `json:"position"`
+	QueueID    int    `json:"queueId"`
+	Spell1ID   int    `json:"spell1Id"` // D slot
+	Spell2ID   int    `json:"spell2Id"` // F slot
+}
+
+// ItemSetPreset is a saved item set for a champion, role and queue.
+// An empty Position or zero QueueID matches any role or queue.
+type ItemSetPreset struct {
+	ChampionID int         `json:"championId"`
+	Position   string      `json:"position"`
+	QueueID    int         `json:"queueId"`
+	Title      string      `json:"title"`
+	Blocks     []ItemBlock `json:"blocks"`
+}
+
+// ItemBlock is a titled group of items in an item set.
+type ItemBlock struct {
+	Type  string     `json:"type"`
+	Items []ItemSlot `json:"items"`
+}
+
+// ItemSlot is an item and count inside an item block.
+type ItemSlot struct {
+	ID    string `json:"id"`
+	Count int    `json:"count"`
+}
+
+// SpellStore persists summoner spell presets keyed by champion, role and queue.
+type SpellStore struct {
+	store *store[SpellPreset]
+}
+
+// ItemSetStore persists item set presets keyed by champion, role and queue.
+type ItemSetStore struct {
+	store *store[ItemSetPreset]
+}
+
+// LoadSpellStore loads the summoner spell presets from the given config directory.
+func LoadSpellStore(dir string) (*SpellStore, error) {
+	s, err := newStore(filepath.Join(dir, spellsFile), func(p SpellPreset) string {
+		return loadoutKey(p.ChampionID, p.Position, p.QueueID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load spell presets: %w", err)
+	}
+	return &SpellStore{store: s}, nil
+}
+
+// List returns all summoner spell presets.
+func (s *SpellStore) List() []SpellPreset {
+	return s.store.list()
+}
+
+// Find returns the most specific preset for a champion, position and queue.
+func (s *SpellStore) Find(championID int, position string, queueID int) (SpellPreset, bool) {
+	for _, key := range loadoutFallbacks(championID, position, queueID) {
+		if preset, ok := s.store.get(key); ok {
+			return preset, true
+		}
+	}
+	return SpellPreset{}, false
+}
+
+// Save adds or replaces a summoner spell preset.
+func (s *SpellStore) Save(preset SpellPreset) error {
+	if preset.ChampionID <= 0 {
+		return fmt.Errorf("champion is required")
+	}
+	if preset.Spell1ID == 0 || preset.Spell2ID == 0 || preset.Spell1ID == preset.Spell2ID {
+		return fmt.Errorf("two different summoner spells are required")
+	}
+	return s.store.put(preset)
+}
+
+// Delete removes the summoner spell preset for a champion, position and queue.
+func (s *SpellStore) Delete(championID int, position string, queueID int) error {
+	return s.store.remove(loadoutKey(championID, position, queueID))
+}
+
+// LoadItemSetStore loads the item set presets from the given config directory.
+func LoadItemSetStore(dir string) (*ItemSetStore, error) {
+	s, err := newStore(filepath.Join(dir, itemSetsFile), func(p ItemSetPreset) string {
+		return loadoutKey(p.ChampionID, p.Position, p.QueueID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load item set presets: %w", err)
+	}
+	return &ItemSetStore{store: s}, nil
+}
+
+// List returns all item set presets.
+func (s *ItemSetStore) List() []ItemSetPreset {
+	return s.store.list()
+}
+
+// Find returns the most specific preset for a champion, position and queue.
+func (s *ItemSetStore) Find(championID int, position string, queueID int) (ItemSetPreset, bool) {
+	for _, key := range loadoutFallbacks(championID, position, queueID) {
+		if preset, ok := s.store.get(key); ok {
+			return preset, true
+		}
+	}
+	return ItemSetPreset{}, false
+}
+
+// Save adds or replaces an item set preset.
+func (s *ItemSetStore) Save(preset ItemSetPreset) error {
+	if preset.ChampionID <= 0 {
+		return fmt.Errorf("champion is required")
+	}
+	if preset.Title == "" || len(preset.Blocks) == 0 {
+		return fmt.Errorf("item set needs a title and at least one block")
+	}
+	return s.store.put(preset)
+}
+
+// Delete removes the item set preset for a champion, position and queue.
+func (s *ItemSetStore) Delete(championID int, position string, queueID int) error {
+	return s.store.remove(loadoutKey(championID, position, queueID))
+}
+
+// loadoutKey identifies a preset by champion, role and queue.
+func loadoutKey(championID int, position string, queueID int) string {
+	return fmt.Sprintf("%d:%s:%d", championID, position, queueID)
+}
+
+// loadoutFallbacks returns lookup keys from most to least specific.
+func loadoutFallbacks(championID int, position string, queueID int) []string {
+	return []string{
+		loadoutKey(championID, position, queueID),
+		loadoutKey(championID, position, 0),
+		loadoutKey(championID, "", queueID),
+		loadoutKey(championID, "", 0),
+	}
+}
@@ -0,0 +1,69 @@
+package presets
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// runesFile is the rune preset file in the config directory.
+const runesFile = "runes.json"
+
+// RunePreset is a saved rune page for a champion and role.
+type RunePreset struct {
+	ChampionID      int    `json:"championId"`
+	Position        string `json:"position"` // assigned position, "" for any role
+	Name            string `json:"name"`
+	PrimaryStyleID  int    `json:"primaryStyleId"`
+	SubStyleID      int    `json:"subStyleId"`
+	SelectedPerkIDs []int  `json:"selectedPerkIds"`
+}
+
+// RuneStore persists rune presets keyed by champion and role.
+type RuneStore struct {
+	store *store[RunePreset]
+}
+
+// LoadRuneStore loads the rune presets from the given config directory.
+func LoadRuneStore(dir string) (*RuneStore, error) {
+	s, err := newStore(filepath.Join(dir, runesFile), func(p RunePreset) string {
+		return runeKey(p.ChampionID, p.Position)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rune presets: %w", err)
+	}
+	return &RuneStore{store: s}, nil
+}
+
+// List returns all rune presets.
+func (r *RuneStore) List() []RunePreset {
+	return r.store.list()
+}
+
+// Find returns the preset for a champion and position, falling back to the any-role preset.
+func (r *RuneStore) Find(championID int, position string) (RunePreset, bool) {
+	if preset, ok := r.store.get(runeKey(championID, position)); ok {
+		return preset, true
+	}
+	return r.store.get(runeKey(championID, ""))
+}
+
+// Save adds or replaces a rune preset.
+func (r *RuneStore) Save(preset RunePreset) error {
+	if preset.ChampionID <= 0 {
+		return fmt.Errorf("champion is required")
+	}
+	if preset.PrimaryStyleID == 0 || preset.SubStyleID == 0 || len(preset.SelectedPerkIDs) == 0 {
+		return fmt.Errorf("rune preset is incomplete")
+	}
+	return r.store.put(preset)
+}
+
+// Delete removes the preset for a champion and position.
+func (r *RuneStore) Delete(championID int, position string) error {
+	return r.store.remove(runeKey(championID, position))
+}
+
+// runeKey identifies a rune preset.
+func runeKey(championID int, position string) string {
+	return fmt.Sprintf("%d:%s", championID, position)
+}
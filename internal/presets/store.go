@@ -0,0 +1,95 @@
+package presets
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+)
+
+// store is a JSON file holding a list of presets identified by a key.
+type store[T any] struct {
+	path  string
+	key   func(T) string
+	items []T
+	mu    sync.RWMutex
+}
+
+// newStore loads the presets at path. A missing file is an empty store.
+func newStore[T any](path string, key func(T) string) (*store[T], error) {
+	s := &store[T]{path: path, key: key}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &s.items); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// list returns a copy of all presets.
+func (s *store[T]) list() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]T{}, s.items...)
+}
+
+// get returns the preset with the given key.
+func (s *store[T]) get(key string) (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, item := range s.items {
+		if s.key(item) == key {
+			return item, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// put adds a preset or replaces the one with the same key, then saves.
+func (s *store[T]) put(item T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := s.key(item)
+	for i, existing := range s.items {
+		if s.key(existing) == key {
+			s.items[i] = item
+			return s.save()
+		}
+	}
+
+	s.items = append(s.items, item)
+	return s.save()
+}
+
+// remove deletes the preset with the given key, then saves.
+func (s *store[T]) remove(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, existing := range s.items {
+		if s.key(existing) == key {
+			s.items = append(s.items[:i], s.items[i+1:]...)
+			return s.save()
+		}
+	}
+	return nil
+}
+
+// save writes the presets to disk. The caller must hold s.mu.
+func (s *store[T]) save() error {
+	data, err := json.MarshalIndent(s.items, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0644)
+}
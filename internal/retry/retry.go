@@ -0,0 +1,92 @@
+// Package retry retries transient API failures with jittered exponential backoff.
+// It is shared by the Riot API and LCU clients, which pick a Policy per endpoint class.
+package retry
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"slices"
+	"syscall"
+	"time"
+)
+
+// Policy decides whether and when a failed attempt is retried.
+type Policy struct {
+	MaxAttempts     int           // total attempts including the first; 1 disables retries
+	BaseDelay       time.Duration // delay before the second attempt, doubled for each further retry
+	MaxDelay        time.Duration // upper bound for a single delay
+	RetryableStatus []int         // HTTP status codes worth retrying
+	RetryNetwork    bool          // retry connection refused/reset and timeouts
+	RetryDial       bool          // retry failures to connect, where the request was never sent
+}
+
+// NoRetry runs an operation exactly once.
+var NoRetry = Policy{MaxAttempts: 1}
+
+// Do runs fn until it succeeds, the policy gives up or attempts run out.
+// fn receives the attempt number (starting at 1) and returns the HTTP status it saw
+// (0 if none) along with its error. The last error is returned.
+func Do(policy Policy, fn func(attempt int) (status int, err error)) error {
+	for attempt := 1; ; attempt++ {
+		status, err := fn(attempt)
+		if err == nil {
+			return nil
+		}
+		if attempt >= policy.MaxAttempts || !policy.Retryable(status, err) {
+			return err
+		}
+		time.Sleep(policy.Backoff(attempt))
+	}
+}
+
+// Retryable reports whether a failure with the given status and error should be retried.
+func (p Policy) Retryable(status int, err error) bool {
+	if status != 0 {
+		return slices.Contains(p.RetryableStatus, status)
+	}
+	return (p.RetryNetwork && IsNetworkError(err)) || (p.RetryDial && IsDialError(err))
+}
+
+// Backoff returns the delay after the given failed attempt: exponential growth
+// capped at MaxDelay, with jitter between half and the full delay.
+func (p Policy) Backoff(attempt int) time.Duration {
+	delay := p.BaseDelay << (attempt - 1)
+	if p.MaxDelay > 0 && (delay > p.MaxDelay || delay <= 0) {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
+
+// IsNetworkError reports whether err is a transport failure rather than an API response.
+func IsNetworkError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET)
+}
+
+// IsDialError reports whether err is a failure to connect. The request was never sent,
+// so retrying it cannot repeat a write, unlike after a timeout or a reset connection.
+func IsDialError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
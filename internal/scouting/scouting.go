@@ -0,0 +1,269 @@
+// Package scouting builds pre-game reports on champ select teammates from the Riot API:
+// rank, recent form, main roles and mastery on the champion they are playing.
+package scouting
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"sync"
+
+	"lol-toolkit/internal/lol"
+)
+
+// Scouting limits
+const (
+	recentMatchCount = 10 // matches used for recent form and main roles
+	maxLookups       = 6  // Riot API requests in flight at once; the client's rate limiter still applies
+)
+
+// Teammate identifies a champ select player to scout.
+type Teammate struct {
+	CellID     int    `json:"cellId"`
+	PUUID      string `json:"puuid"`      // empty when the player is hidden
+	Position   string `json:"position"`   // assigned position, if any
+	ChampionID int    `json:"championId"` // picked or hovered champion, 0 if none
+	IsLocal    bool   `json:"isLocal"`
+}
+
+// Report is the scouting report on one teammate.
+type Report struct {
+	Teammate
+	GameName      string                   `json:"gameName"`
+	TagLine       string                   `json:"tagLine"`
+	SummonerLevel int                      `json:"summonerLevel"`
+	Ranked        []*lol.RankedInfo        `json:"ranked"`
+	RecentGames   int                      `json:"recentGames"`
+	RecentWins    int                      `json:"recentWins"`
+	RecentWinRate float64                  `json:"recentWinRate"` // 0-1
+	MainRoles     []*RoleShare             `json:"mainRoles"`     // most played first
+	Mastery       *lol.ChampionMasteryInfo `json:"mastery,omitempty"`
+	Hidden        bool                     `json:"hidden"` // the player's identity is not visible
+	Error         string                   `json:"error,omitempty"`
+}
+
+// RoleShare is how often a role was played in recent matches.
+type RoleShare struct {
+	Position string  `json:"position"`
+	Games    int     `json:"games"`
+	Share    float64 `json:"share"` // 0-1
+}
+
+// profile is everything known about a player, independent of the champion they hover.
+type profile struct {
+	summoner  *lol.SummonerInfo
+	ranked    []*lol.RankedInfo
+	masteries []*lol.ChampionMasteryInfo
+	matches   []*lol.MatchInfo
+	err       error
+}
+
+// profileEntry is a profile being loaded or loaded. done is closed once loading finishes.
+type profileEntry struct {
+	done    chan struct{}
+	profile *profile
+}
+
+// Scout loads and remembers teammate profiles for the current champ select.
+// It is safe for concurrent use; concurrent requests for the same player share one lookup.
+type Scout struct {
+	lookups  chan struct{}
+	profiles map[string]*profileEntry
+	mu       sync.Mutex
+}
+
+// New creates a scout.
+func New() *Scout {
+	return &Scout{
+		lookups:  make(chan struct{}, maxLookups),
+		profiles: make(map[string]*profileEntry),
+	}
+}
+
+// Reset forgets the loaded profiles, e.g. when champ select ends.
+func (s *Scout) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.profiles = make(map[string]*profileEntry)
+}
+
+// Reports returns a report for every teammate, in the given order. Profiles are loaded
+// concurrently the first time a player is seen; later calls only update the mastery
+// for the champion each player is on.
+func (s *Scout) Reports(client *lol.Client, teammates []Teammate) []*Report {
+	reports := make([]*Report, len(teammates))
+
+	var wg sync.WaitGroup
+	for i, teammate := range teammates {
+		if teammate.PUUID == "" {
+			reports[i] = &Report{Teammate: teammate, Hidden: true}
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reports[i] = newReport(teammate, s.profile(client, teammate.PUUID))
+		}()
+	}
+	wg.Wait()
+
+	return reports
+}
+
+// profile returns the player's profile, loading it if no other call has.
+func (s *Scout) profile(client *lol.Client, puuid string) *profile {
+	s.mu.Lock()
+	entry, loading := s.profiles[puuid]
+	if !loading {
+		entry = &profileEntry{done: make(chan struct{})}
+		s.profiles[puuid] = entry
+	}
+	s.mu.Unlock()
+
+	if loading {
+		<-entry.done
+		return entry.profile
+	}
+
+	entry.profile = s.load(client, puuid)
+	if entry.profile.err != nil {
+		// Let the next report try again
+		s.mu.Lock()
+		if s.profiles[puuid] == entry {
+			delete(s.profiles, puuid)
+		}
+		s.mu.Unlock()
+	}
+	close(entry.done)
+	return entry.profile
+}
+
+// load fetches a player's summoner, ranked entries, masteries and recent matches.
+func (s *Scout) load(client *lol.Client, puuid string) *profile {
+	p := &profile{}
+
+	// The two lookups write to separate fields, so they need no locking
+	var wg sync.WaitGroup
+
+	// Ranked entries and masteries are keyed by summoner ID, so the summoner comes first
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		summoner, err := lookup(s, func() (*lol.SummonerInfo, error) { return client.GetSummonerByPUUID(puuid) })
+		if err != nil {
+			p.err = err
+			return
+		}
+		p.summoner = summoner
+
+		var inner sync.WaitGroup
+		inner.Add(2)
+		go func() {
+			defer inner.Done()
+			if ranked, err := lookup(s, func() ([]*lol.RankedInfo, error) { return client.GetRankedStats(summoner.ID) }); err == nil {
+				p.ranked = ranked
+			}
+		}()
+		go func() {
+			defer inner.Done()
+			if masteries, err := lookup(s, func() ([]*lol.ChampionMasteryInfo, error) { return client.GetAllChampionMasteries(summoner.ID) }); err == nil {
+				p.masteries = masteries
+			}
+		}()
+		inner.Wait()
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ids, err := lookup(s, func() ([]string, error) {
+			return client.GetMatchIDs(puuid, lol.MatchFilters{Count: recentMatchCount})
+		})
+		if err != nil {
+			return
+		}
+
+		matches := make([]*lol.MatchInfo, len(ids))
+		var inner sync.WaitGroup
+		for i, id := range ids {
+			inner.Add(1)
+			go func() {
+				defer inner.Done()
+				if match, err := lookup(s, func() (*lol.MatchInfo, error) { return client.GetMatch(id) }); err == nil {
+					matches[i] = match
+				}
+			}()
+		}
+		inner.Wait()
+
+		p.matches = slices.DeleteFunc(matches, func(m *lol.MatchInfo) bool { return m == nil })
+	}()
+
+	wg.Wait()
+	return p
+}
+
+// lookup runs one Riot API call while holding a lookup slot.
+func lookup[T any](s *Scout, fn func() (T, error)) (T, error) {
+	s.lookups <- struct{}{}
+	defer func() { <-s.lookups }()
+	return fn()
+}
+
+// newReport combines a teammate's profile with the champion they are on.
+func newReport(teammate Teammate, p *profile) *Report {
+	report := &Report{Teammate: teammate, Ranked: []*lol.RankedInfo{}, MainRoles: []*RoleShare{}}
+	if p.err != nil {
+		report.Error = p.err.Error()
+		return report
+	}
+
+	report.GameName = p.summoner.GameName
+	report.TagLine = p.summoner.TagLine
+	report.SummonerLevel = p.summoner.SummonerLevel
+	if p.ranked != nil {
+		report.Ranked = p.ranked
+	}
+
+	for _, mastery := range p.masteries {
+		if teammate.ChampionID != 0 && mastery.ChampionID == teammate.ChampionID {
+			report.Mastery = mastery
+			break
+		}
+	}
+
+	roles := make(map[string]int)
+	for _, match := range p.matches {
+		for _, participant := range match.Participants {
+			if participant.PUUID != teammate.PUUID || participant.EarlySurrender {
+				continue
+			}
+			report.RecentGames++
+			if participant.Win {
+				report.RecentWins++
+			}
+			if participant.Position != "" {
+				roles[strings.ToLower(participant.Position)]++
+			}
+		}
+	}
+	if report.RecentGames > 0 {
+		report.RecentWinRate = float64(report.RecentWins) / float64(report.RecentGames)
+	}
+
+	for position, games := range roles {
+		report.MainRoles = append(report.MainRoles, &RoleShare{
+			Position: position,
+			Games:    games,
+			Share:    float64(games) / float64(report.RecentGames),
+		})
+	}
+	slices.SortFunc(report.MainRoles, func(a, b *RoleShare) int {
+		return cmp.Or(cmp.Compare(b.Games, a.Games), cmp.Compare(a.Position, b.Position))
+	})
+
+	return report
+}
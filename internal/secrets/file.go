@@ -0,0 +1,160 @@
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// File store names. The key file sits next to the data, so the encryption keeps secrets out of
+// copies of the data file and of the config, not away from someone who can read the directory.
+const (
+	secretsFile = "secrets.enc"
+	keyFile     = "secrets.key"
+)
+
+// FileStore keeps secrets in an AES-GCM encrypted JSON file, readable only by the current user.
+type FileStore struct {
+	mu      sync.Mutex
+	path    string
+	keyPath string
+}
+
+// NewFileStore creates a file store in dir. Files are created on the first Set.
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{
+		path:    filepath.Join(dir, secretsFile),
+		keyPath: filepath.Join(dir, keyFile),
+	}
+}
+
+// Get implements Store.
+func (s *FileStore) Get(name string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	values, err := s.read()
+	if err != nil {
+		return "", err
+	}
+	value, ok := values[name]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return value, nil
+}
+
+// Set implements Store.
+func (s *FileStore) Set(name, value string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	values, err := s.read()
+	if err != nil {
+		return err
+	}
+	values[name] = value
+	return s.write(values)
+}
+
+// Delete implements Store.
+func (s *FileStore) Delete(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	values, err := s.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := values[name]; !ok {
+		return nil
+	}
+	delete(values, name)
+	return s.write(values)
+}
+
+// Backend implements Store.
+func (s *FileStore) Backend() string {
+	return BackendFile
+}
+
+// read decrypts every secret. A missing file holds none.
+func (s *FileStore) read() (map[string]string, error) {
+	values := make(map[string]string)
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return values, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := s.cipher(false)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("secrets file is corrupt")
+	}
+	plain, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt secrets: %w", err)
+	}
+
+	if err := json.Unmarshal(plain, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// write encrypts every secret with a fresh nonce and replaces the file.
+func (s *FileStore) write(values map[string]string) error {
+	plain, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	gcm, err := s.cipher(true)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, gcm.Seal(nonce, nonce, plain, nil), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
+
+// cipher loads the encryption key, generating it if create is set and there is none yet.
+func (s *FileStore) cipher(create bool) (cipher.AEAD, error) {
+	key, err := os.ReadFile(s.keyPath)
+	if errors.Is(err, fs.ErrNotExist) && create {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		err = os.WriteFile(s.keyPath, key, 0600)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,55 @@
+//go:build darwin
+
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// errItemNotFound is the exit status of security(1) for a missing keychain item.
+const errItemNotFound = 44
+
+// keyringAvailable reports whether the security tool for the macOS keychain is installed.
+func keyringAvailable() bool {
+	_, err := exec.LookPath("security")
+	return err == nil
+}
+
+// keyringGet reads a generic password from the login keychain.
+func keyringGet(name string) (string, error) {
+	output, err := exec.Command("security", "find-generic-password", "-s", service, "-a", name, "-w").Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == errItemNotFound {
+			return "", ErrNotFound
+		}
+		return "", err
+	}
+	return strings.TrimSuffix(string(output), "\n"), nil
+}
+
+// keyringSet adds or updates a generic password. The command is sent on stdin
+// in interactive mode so the value does not show up in the process list.
+func keyringSet(name, value string) error {
+	cmd := exec.Command("security", "-i")
+	cmd.Stdin = strings.NewReader(fmt.Sprintf("add-generic-password -U -s %s -a %s -w %s\n", quote(service), quote(name), quote(value)))
+	return cmd.Run()
+}
+
+// keyringDelete removes a generic password.
+func keyringDelete(name string) error {
+	err := exec.Command("security", "delete-generic-password", "-s", service, "-a", name).Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == errItemNotFound {
+		return nil
+	}
+	return err
+}
+
+// quote wraps an argument for the security(1) interactive command line.
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,59 @@
+//go:build linux
+
+package secrets
+
+import (
+	"bytes"
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+// probeName is looked up to check that the Secret Service answers.
+const probeName = "__probe__"
+
+// keyringAvailable reports whether secret-tool is installed and the Secret Service is running.
+// A lookup of a missing secret fails silently; without a Secret Service it prints an error.
+func keyringAvailable() bool {
+	if _, err := exec.LookPath("secret-tool"); err != nil {
+		return false
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command("secret-tool", "lookup", "service", service, "account", probeName)
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+
+	var exitErr *exec.ExitError
+	return err == nil || (errors.As(err, &exitErr) && stderr.Len() == 0)
+}
+
+// keyringGet reads a secret from the Secret Service via secret-tool.
+func keyringGet(name string) (string, error) {
+	output, err := exec.Command("secret-tool", "lookup", "service", service, "account", name).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) == 0 {
+			return "", ErrNotFound
+		}
+		return "", err
+	}
+	return strings.TrimSuffix(string(output), "\n"), nil
+}
+
+// keyringSet stores a secret in the Secret Service. The value is passed on stdin, not in the arguments.
+func keyringSet(name, value string) error {
+	cmd := exec.Command("secret-tool", "store", "--label", service+" "+name, "service", service, "account", name)
+	cmd.Stdin = strings.NewReader(value)
+	return cmd.Run()
+}
+
+// keyringDelete removes a secret from the Secret Service.
+func keyringDelete(name string) error {
+	_, err := exec.Command("secret-tool", "clear", "service", service, "account", name).Output()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && len(exitErr.Stderr) == 0 {
+		return nil // nothing to clear
+	}
+	return err
+}
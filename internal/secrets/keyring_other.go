@@ -0,0 +1,25 @@
+//go:build !windows && !linux && !darwin
+
+package secrets
+
+import "fmt"
+
+// keyringAvailable reports that there is no supported keyring on this platform.
+func keyringAvailable() bool {
+	return false
+}
+
+// keyringGet is not supported on this platform.
+func keyringGet(name string) (string, error) {
+	return "", fmt.Errorf("keyring not supported on this platform")
+}
+
+// keyringSet is not supported on this platform.
+func keyringSet(name, value string) error {
+	return fmt.Errorf("keyring not supported on this platform")
+}
+
+// keyringDelete is not supported on this platform.
+func keyringDelete(name string) error {
+	return fmt.Errorf("keyring not supported on this platform")
+}
@@ -0,0 +1,113 @@
+//go:build windows
+
+package secrets
+
+import (
+	"errors"
+	"syscall"
+	"unsafe"
+)
+
+// Windows Credential Manager constants
+const (
+	credTypeGeneric         = 1
+	credPersistLocalMachine = 2
+	errorNotFound           = syscall.Errno(1168)
+)
+
+var (
+	advapi32       = syscall.NewLazyDLL("advapi32.dll")
+	procCredRead   = advapi32.NewProc("CredReadW")
+	procCredWrite  = advapi32.NewProc("CredWriteW")
+	procCredDelete = advapi32.NewProc("CredDeleteW")
+	procCredFree   = advapi32.NewProc("CredFree")
+)
+
+// credential mirrors the Win32 CREDENTIALW structure.
+type credential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        syscall.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+// keyringAvailable reports whether the Credential Manager API can be loaded.
+func keyringAvailable() bool {
+	return procCredRead.Find() == nil && procCredWrite.Find() == nil && procCredDelete.Find() == nil
+}
+
+// keyringGet reads a generic credential from the Credential Manager.
+func keyringGet(name string) (string, error) {
+	target, err := syscall.UTF16PtrFromString(targetName(name))
+	if err != nil {
+		return "", err
+	}
+
+	var cred *credential
+	ret, _, callErr := procCredRead.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0, uintptr(unsafe.Pointer(&cred)))
+	if ret == 0 {
+		if errors.Is(callErr, errorNotFound) {
+			return "", ErrNotFound
+		}
+		return "", callErr
+	}
+	defer procCredFree.Call(uintptr(unsafe.Pointer(cred)))
+
+	return string(unsafe.Slice(cred.CredentialBlob, cred.CredentialBlobSize)), nil
+}
+
+// keyringSet creates or replaces a generic credential in the Credential Manager.
+func keyringSet(name, value string) error {
+	target, err := syscall.UTF16PtrFromString(targetName(name))
+	if err != nil {
+		return err
+	}
+	user, err := syscall.UTF16PtrFromString(name)
+	if err != nil {
+		return err
+	}
+
+	blob := []byte(value)
+	cred := credential{
+		Type:               credTypeGeneric,
+		TargetName:         target,
+		CredentialBlobSize: uint32(len(blob)),
+		Persist:            credPersistLocalMachine,
+		UserName:           user,
+	}
+	if len(blob) > 0 {
+		cred.CredentialBlob = &blob[0]
+	}
+
+	if ret, _, callErr := procCredWrite.Call(uintptr(unsafe.Pointer(&cred)), 0); ret == 0 {
+		return callErr
+	}
+	return nil
+}
+
+// keyringDelete removes a generic credential from the Credential Manager.
+func keyringDelete(name string) error {
+	target, err := syscall.UTF16PtrFromString(targetName(name))
+	if err != nil {
+		return err
+	}
+
+	ret, _, callErr := procCredDelete.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0)
+	if ret == 0 && !errors.Is(callErr, errorNotFound) {
+		return callErr
+	}
+	return nil
+}
+
+// targetName is the Credential Manager target of a secret.
+func targetName(name string) string {
+	return service + "/" + name
+}
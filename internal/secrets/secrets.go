@@ -0,0 +1,69 @@
+// Package secrets keeps credentials such as Riot API keys out of the config file.
+// Secrets live in the OS keyring when one is available and in an encrypted file otherwise.
+package secrets
+
+import (
+	"errors"
+)
+
+// service is the keyring service name every secret is stored under.
+const service = "lol-toolkit"
+
+// Backend names reported by Store.Backend
+const (
+	BackendKeyring = "keyring"
+	BackendFile    = "file"
+)
+
+// ErrNotFound is returned by Get for a secret that is not stored.
+var ErrNotFound = errors.New("secret not found")
+
+// Store keeps named secrets.
+type Store interface {
+	Get(name string) (string, error)
+	Set(name, value string) error
+	Delete(name string) error // deleting a missing secret is not an error
+	Backend() string
+}
+
+// Open returns the OS keyring if it is usable, or else an encrypted file store in dir.
+func Open(dir string) Store {
+	if keyringAvailable() {
+		return keyring{}
+	}
+	return NewFileStore(dir)
+}
+
+// keyring stores secrets in the OS keyring through the platform functions.
+type keyring struct{}
+
+// Get implements Store.
+func (keyring) Get(name string) (string, error) {
+	return keyringGet(name)
+}
+
+// Set implements Store.
+func (keyring) Set(name, value string) error {
+	return keyringSet(name, value)
+}
+
+// Delete implements Store.
+func (keyring) Delete(name string) error {
+	return keyringDelete(name)
+}
+
+// Backend implements Store.
+func (keyring) Backend() string {
+	return BackendKeyring
+}
+
+// Redact hides a secret for display, keeping only the last four characters.
+func Redact(value string) string {
+	if value == "" {
+		return ""
+	}
+	if len(value) <= 8 {
+		return "****"
+	}
+	return "****" + value[len(value)-4:]
+}
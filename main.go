@@ -2,20 +2,27 @@ package main
 
 import (
 	"embed"
+	"os"
 
 	"github.com/wailsapp/wails/v2"
 	"github.com/wailsapp/wails/v2/pkg/options"
 	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
 
+	"lol-toolkit/internal/apierr"
 	"lol-toolkit/internal/app"
+	"lol-toolkit/internal/config"
 )
 
 //go:embed all:frontend/dist
 var assets embed.FS
 
 func main() {
+	if len(os.Args) > 2 && os.Args[1] == "config" && os.Args[2] == "doctor" {
+		os.Exit(configDoctor(os.Args[3:]))
+	}
+
 	// Create an instance of the app structure
-	application := app.New()
+	application := app.New(os.Args[1:])
 
 	// Create application with options
 	err := wails.Run(&options.App{
@@ -28,6 +35,7 @@ func main() {
 		BackgroundColour: &options.RGBA{R: 15, G: 23, B: 42, A: 1},
 		OnStartup:        application.Startup,
 		OnShutdown:       application.Shutdown,
+		ErrorFormatter:   apierr.Format,
 		Bind: []interface{}{
 			application,
 		},
@@ -37,3 +45,16 @@ func main() {
 		println("Error:", err.Error())
 	}
 }
+
+// configDoctor prints where each effective config value comes from, for
+// `lol-toolkit config doctor [flags]`. It exits with 1 if the config has problems.
+func configDoctor(args []string) int {
+	report := config.LoadReadOnly(args).Doctor()
+	if err := report.WriteText(os.Stdout); err != nil {
+		return 1
+	}
+	if len(report.Issues) > 0 {
+		return 1
+	}
+	return 0
+}